@@ -0,0 +1,112 @@
+// Package errs classifies errors returned by the Lightsail API.
+//
+// Reads should only remove a resource from state when the resource is genuinely
+// gone (IsNotFound). Throttling, access denied and service errors are returned to
+// the caller so that a transient failure never plans the recreation of a resource.
+package errs
+
+import (
+	"errors"
+
+	"github.com/aws/smithy-go"
+)
+
+// Error codes returned by the Lightsail API and the AWS SDK.
+const (
+	ErrCodeAccessDenied       = "AccessDeniedException"
+	ErrCodeNotFound           = "NotFoundException"
+	ErrCodeService            = "ServiceException"
+	ErrCodeUnauthenticated    = "UnauthenticatedException"
+	ErrCodeExpiredToken       = "ExpiredTokenException"
+	ErrCodeThrottling         = "ThrottlingException"
+	ErrCodeThrottled          = "Throttling"
+	ErrCodeTooManyRequests    = "TooManyRequestsException"
+	ErrCodeRequestLimit       = "RequestLimitExceeded"
+	ErrCodeInternalFailure    = "InternalFailure"
+	ErrCodeServiceUnavailable = "ServiceUnavailable"
+)
+
+// Class is the classification of an error.
+type Class int
+
+const (
+	// ClassNone is the class of a nil error.
+	ClassNone Class = iota
+	// ClassNotFound is the class of errors returned for resources which do not exist.
+	ClassNotFound
+	// ClassAccessDenied is the class of errors returned for missing permissions or invalid credentials.
+	ClassAccessDenied
+	// ClassThrottling is the class of errors returned when requests are rate limited.
+	ClassThrottling
+	// ClassService is the class of errors caused by a failure of the service.
+	ClassService
+	// ClassOther is the class of every other error, e.g. invalid input or network failures.
+	ClassOther
+)
+
+// String returns the name of the class.
+func (c Class) String() string {
+	switch c {
+	case ClassNone:
+		return "none"
+	case ClassNotFound:
+		return "not found"
+	case ClassAccessDenied:
+		return "access denied"
+	case ClassThrottling:
+		return "throttling"
+	case ClassService:
+		return "service"
+	default:
+		return "other"
+	}
+}
+
+// Classify returns the class of err based on the smithy.APIError code it wraps.
+func Classify(err error) Class {
+	if err == nil {
+		return ClassNone
+	}
+
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return ClassOther
+	}
+
+	switch apiErr.ErrorCode() {
+	case ErrCodeNotFound:
+		return ClassNotFound
+	case ErrCodeAccessDenied, ErrCodeUnauthenticated, ErrCodeExpiredToken:
+		return ClassAccessDenied
+	case ErrCodeThrottling, ErrCodeThrottled, ErrCodeTooManyRequests, ErrCodeRequestLimit:
+		return ClassThrottling
+	case ErrCodeService, ErrCodeInternalFailure, ErrCodeServiceUnavailable:
+		return ClassService
+	}
+
+	if apiErr.ErrorFault() == smithy.FaultServer {
+		return ClassService
+	}
+
+	return ClassOther
+}
+
+// IsNotFound reports whether err is returned for a resource which does not exist.
+func IsNotFound(err error) bool {
+	return Classify(err) == ClassNotFound
+}
+
+// IsAccessDenied reports whether err is returned for missing permissions or invalid credentials.
+func IsAccessDenied(err error) bool {
+	return Classify(err) == ClassAccessDenied
+}
+
+// IsThrottling reports whether err is returned when requests are rate limited.
+func IsThrottling(err error) bool {
+	return Classify(err) == ClassThrottling
+}
+
+// IsServiceError reports whether err is caused by a failure of the service.
+func IsServiceError(err error) bool {
+	return Classify(err) == ClassService
+}
@@ -0,0 +1,49 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/aws/smithy-go"
+)
+
+func TestClassify(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Err      error
+		Expected Class
+	}{
+		{"nil", nil, ClassNone},
+		{"not found", &types.NotFoundException{}, ClassNotFound},
+		{"wrapped not found", fmt.Errorf("error reading: %w", &smithy.OperationError{ServiceID: "Lightsail", OperationName: "GetDisk", Err: &types.NotFoundException{}}), ClassNotFound},
+		{"access denied", &types.AccessDeniedException{}, ClassAccessDenied},
+		{"unauthenticated", &types.UnauthenticatedException{}, ClassAccessDenied},
+		{"expired token", &smithy.GenericAPIError{Code: ErrCodeExpiredToken}, ClassAccessDenied},
+		{"throttling", &smithy.GenericAPIError{Code: ErrCodeThrottling}, ClassThrottling},
+		{"too many requests", &smithy.GenericAPIError{Code: ErrCodeTooManyRequests}, ClassThrottling},
+		{"service", &types.ServiceException{}, ClassService},
+		{"server fault", &smithy.GenericAPIError{Code: "Unknown", Fault: smithy.FaultServer}, ClassService},
+		{"invalid input", &types.InvalidInputException{}, ClassOther},
+		{"plain error", errors.New("connection reset"), ClassOther},
+	}
+
+	for _, tc := range cases {
+		if actual := Classify(tc.Err); actual != tc.Expected {
+			t.Errorf("%s: expected %s, got %s", tc.Name, tc.Expected, actual)
+		}
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	if !IsNotFound(&types.NotFoundException{}) {
+		t.Errorf("expected NotFoundException to be not found")
+	}
+
+	for _, err := range []error{nil, &types.AccessDeniedException{}, &smithy.GenericAPIError{Code: ErrCodeThrottling}, &types.ServiceException{}} {
+		if IsNotFound(err) {
+			t.Errorf("expected %v not to be not found", err)
+		}
+	}
+}
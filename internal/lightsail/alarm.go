@@ -0,0 +1,508 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// alarmMetricNames are the metrics that can be monitored by an alarm for each resource type
+var alarmMetricNames = map[types.ResourceType][]string{
+	types.ResourceTypeInstance: {
+		"BurstCapacityPercentage",
+		"BurstCapacityTime",
+		"CPUUtilization",
+		"NetworkIn",
+		"NetworkOut",
+		"StatusCheckFailed",
+		"StatusCheckFailed_Instance",
+		"StatusCheckFailed_System",
+	},
+	types.ResourceTypeLoadBalancer: {
+		"ClientTLSNegotiationErrorCount",
+		"HealthyHostCount",
+		"UnhealthyHostCount",
+		"HTTPCode_LB_4XX_Count",
+		"HTTPCode_LB_5XX_Count",
+		"HTTPCode_Instance_2XX_Count",
+		"HTTPCode_Instance_3XX_Count",
+		"HTTPCode_Instance_4XX_Count",
+		"HTTPCode_Instance_5XX_Count",
+		"InstanceResponseTime",
+		"RejectedConnectionCount",
+		"RequestCount",
+	},
+	types.ResourceTypeRelationalDatabase: {
+		"CPUUtilization",
+		"DatabaseConnections",
+		"DiskQueueDepth",
+		"FreeStorageSpace",
+		"NetworkReceiveThroughput",
+		"NetworkTransmitThroughput",
+	},
+}
+
+func ResourceAlarm() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAlarmCreate,
+		Read:   resourceAlarmRead,
+		Update: resourceAlarmUpdate,
+		Delete: resourceAlarmDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z]`), "must begin with an alphabetic character"),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_\-.]+[^._\-]$`), "must contain only alphanumeric characters, underscores, hyphens, and dots"),
+				),
+			},
+			"monitored_resource_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"BurstCapacityPercentage",
+					"BurstCapacityTime",
+					"CPUUtilization",
+					"ClientTLSNegotiationErrorCount",
+					"DatabaseConnections",
+					"DiskQueueDepth",
+					"FreeStorageSpace",
+					"HTTPCode_Instance_2XX_Count",
+					"HTTPCode_Instance_3XX_Count",
+					"HTTPCode_Instance_4XX_Count",
+					"HTTPCode_Instance_5XX_Count",
+					"HTTPCode_LB_4XX_Count",
+					"HTTPCode_LB_5XX_Count",
+					"HealthyHostCount",
+					"InstanceResponseTime",
+					"NetworkIn",
+					"NetworkOut",
+					"NetworkReceiveThroughput",
+					"NetworkTransmitThroughput",
+					"RejectedConnectionCount",
+					"RequestCount",
+					"StatusCheckFailed",
+					"StatusCheckFailed_Instance",
+					"StatusCheckFailed_System",
+					"UnhealthyHostCount",
+				}, false),
+			},
+			"comparison_operator": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GreaterThanOrEqualToThreshold",
+					"GreaterThanThreshold",
+					"LessThanThreshold",
+					"LessThanOrEqualToThreshold",
+				}, false),
+			},
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"evaluation_periods": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"datapoints_to_alarm": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"treat_missing_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "missing",
+				ValidateFunc: validation.StringInSlice([]string{
+					"breaching",
+					"notBreaching",
+					"ignore",
+					"missing",
+				}, false),
+			},
+			"contact_protocols": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Email",
+						"SMS",
+					}, false),
+				},
+			},
+			"notification_triggers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"ALARM",
+						"OK",
+						"INSUFFICIENT_DATA",
+					}, false),
+				},
+			},
+			"notification_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			// alarm testing attributes
+			"test_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ALARM",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ALARM",
+					"OK",
+					"INSUFFICIENT_DATA",
+				}, false),
+			},
+			"test_trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// additional info returned from the API
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitored_resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"statistic": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: resourceAlarmMetricNameDiff,
+	}
+}
+
+func resourceAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	if err := putAlarm(conn, d); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	if len(d.Get("test_trigger").(map[string]interface{})) > 0 {
+		if err := testAlarm(conn, d.Id(), d.Get("test_state").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlarmRead(d, meta)
+}
+
+func resourceAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	if d.HasChangesExcept("test_state", "test_trigger") {
+		if err := putAlarm(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if (d.HasChange("test_trigger") || d.HasChange("test_state")) && len(d.Get("test_trigger").(map[string]interface{})) > 0 {
+		if err := testAlarm(conn, d.Id(), d.Get("test_state").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAlarmRead(d, meta)
+}
+
+func putAlarm(conn *lightsail.Client, d *schema.ResourceData) error {
+	name := d.Get("name").(string)
+	resourceName := d.Get("monitored_resource_name").(string)
+	metricName := d.Get("metric_name").(string)
+
+	resourceType, err := getAlarmMonitoredResourceType(conn, resourceName)
+	if err != nil {
+		return err
+	}
+
+	if err := validateAlarmMetricName(resourceType, resourceName, metricName); err != nil {
+		return err
+	}
+
+	req := lightsail.PutAlarmInput{
+		AlarmName:             aws.String(name),
+		ComparisonOperator:    types.ComparisonOperator(d.Get("comparison_operator").(string)),
+		EvaluationPeriods:     aws.Int32(int32(d.Get("evaluation_periods").(int))),
+		MetricName:            types.MetricName(metricName),
+		MonitoredResourceName: aws.String(resourceName),
+		Threshold:             aws.Float64(d.Get("threshold").(float64)),
+		NotificationEnabled:   aws.Bool(d.Get("notification_enabled").(bool)),
+		TreatMissingData:      types.TreatMissingData(d.Get("treat_missing_data").(string)),
+	}
+
+	if v, ok := d.GetOk("datapoints_to_alarm"); ok {
+		req.DatapointsToAlarm = aws.Int32(int32(v.(int)))
+	}
+
+	// An empty list removes all contact protocols from the alarm
+	req.ContactProtocols = make([]types.ContactProtocol, 0)
+	for _, v := range expandStringSet(d.Get("contact_protocols").(*schema.Set)) {
+		req.ContactProtocols = append(req.ContactProtocols, types.ContactProtocol(v))
+	}
+
+	if v, ok := d.GetOk("notification_triggers"); ok {
+		for _, t := range expandStringSet(v.(*schema.Set)) {
+			req.NotificationTriggers = append(req.NotificationTriggers, types.AlarmState(t))
+		}
+	}
+
+	resp, err := conn.PutAlarm(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for PutAlarm request")
+	}
+
+	op := resp.Operations[0]
+
+	err = waitLightsailOperation(conn, op.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Alarm (%s) to become ready: %s", name, err)
+	}
+
+	return nil
+}
+
+// testAlarm sets the alarm to the given state for a short time to send its notifications
+func testAlarm(conn *lightsail.Client, name, state string) error {
+	log.Printf("[DEBUG] Testing Lightsail Alarm (%s) with state %s", name, state)
+
+	resp, err := conn.TestAlarm(context.TODO(), &lightsail.TestAlarmInput{
+		AlarmName: aws.String(name),
+		State:     types.AlarmState(state),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error testing Lightsail Alarm (%s): %w", name, err)
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for TestAlarm request")
+	}
+
+	op := resp.Operations[0]
+
+	err = waitLightsailOperation(conn, op.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Alarm (%s) to be tested: %s", name, err)
+	}
+
+	return nil
+}
+
+// resourceAlarmMetricNameDiff checks the metric against the monitored resource type when the resource already exists
+func resourceAlarmMetricNameDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("monitored_resource_name") || (!diff.HasChange("monitored_resource_name") && !diff.HasChange("metric_name")) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).LightsailConn
+	resourceName := diff.Get("monitored_resource_name").(string)
+
+	resourceType, err := getAlarmMonitoredResourceType(conn, resourceName)
+	if err != nil {
+		// The monitored resource may be created in the same apply, it is validated again on create.
+		log.Printf("[DEBUG] Skipping metric_name plan time validation for Lightsail Alarm: %s", err)
+		return nil
+	}
+
+	return validateAlarmMetricName(resourceType, resourceName, diff.Get("metric_name").(string))
+}
+
+func resourceAlarmRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	resp, err := conn.GetAlarms(context.TODO(), &lightsail.GetAlarmsInput{
+		AlarmName: aws.String(d.Id()),
+	})
+
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Alarm (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Alarm (%s): %w", d.Id(), err)
+	}
+
+	if len(resp.Alarms) == 0 {
+		log.Printf("[WARN] Lightsail Alarm (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	a := resp.Alarms[0]
+
+	d.Set("name", a.Name)
+	d.Set("metric_name", a.MetricName)
+	d.Set("comparison_operator", a.ComparisonOperator)
+	d.Set("threshold", a.Threshold)
+	d.Set("evaluation_periods", a.EvaluationPeriods)
+	d.Set("datapoints_to_alarm", a.DatapointsToAlarm)
+	d.Set("treat_missing_data", a.TreatMissingData)
+	d.Set("notification_enabled", a.NotificationEnabled)
+
+	if a.MonitoredResourceInfo != nil {
+		d.Set("monitored_resource_name", a.MonitoredResourceInfo.Name)
+		d.Set("monitored_resource_type", a.MonitoredResourceInfo.ResourceType)
+	}
+
+	contactProtocols := make([]string, 0, len(a.ContactProtocols))
+	for _, v := range a.ContactProtocols {
+		contactProtocols = append(contactProtocols, string(v))
+	}
+
+	if err := d.Set("contact_protocols", contactProtocols); err != nil {
+		return fmt.Errorf("error setting contact_protocols: %w", err)
+	}
+
+	notificationTriggers := make([]string, 0, len(a.NotificationTriggers))
+	for _, v := range a.NotificationTriggers {
+		notificationTriggers = append(notificationTriggers, string(v))
+	}
+
+	if err := d.Set("notification_triggers", notificationTriggers); err != nil {
+		return fmt.Errorf("error setting notification_triggers: %w", err)
+	}
+
+	// additional attributes
+	d.Set("arn", a.Arn)
+	d.Set("created_at", a.CreatedAt.Format(time.RFC3339))
+	d.Set("period", a.Period)
+	d.Set("state", a.State)
+	d.Set("statistic", a.Statistic)
+	d.Set("support_code", a.SupportCode)
+	d.Set("unit", a.Unit)
+
+	return nil
+}
+
+func resourceAlarmDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	resp, err := conn.DeleteAlarm(context.TODO(), &lightsail.DeleteAlarmInput{
+		AlarmName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	op := resp.Operations[0]
+
+	err = waitLightsailOperation(conn, op.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Alarm (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// getAlarmMonitoredResourceType returns the type of the instance, database or load balancer with the given name
+func getAlarmMonitoredResourceType(conn *lightsail.Client, name string) (types.ResourceType, error) {
+	_, err := conn.GetInstance(context.TODO(), &lightsail.GetInstanceInput{
+		InstanceName: aws.String(name),
+	})
+	if err == nil {
+		return types.ResourceTypeInstance, nil
+	}
+	if !errs.IsNotFound(err) {
+		return "", fmt.Errorf("error reading Lightsail Instance (%s): %w", name, err)
+	}
+
+	_, err = conn.GetRelationalDatabase(context.TODO(), &lightsail.GetRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(name),
+	})
+	if err == nil {
+		return types.ResourceTypeRelationalDatabase, nil
+	}
+	if !errs.IsNotFound(err) {
+		return "", fmt.Errorf("error reading Lightsail Database (%s): %w", name, err)
+	}
+
+	_, err = conn.GetLoadBalancer(context.TODO(), &lightsail.GetLoadBalancerInput{
+		LoadBalancerName: aws.String(name),
+	})
+	if err == nil {
+		return types.ResourceTypeLoadBalancer, nil
+	}
+	if !errs.IsNotFound(err) {
+		return "", fmt.Errorf("error reading Lightsail Load Balancer (%s): %w", name, err)
+	}
+
+	return "", fmt.Errorf("monitored resource (%s) not found, alarms can only monitor Lightsail instances, databases and load balancers", name)
+}
+
+func validateAlarmMetricName(resourceType types.ResourceType, resourceName, metricName string) error {
+	for _, v := range alarmMetricNames[resourceType] {
+		if v == metricName {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("metric_name %q is not supported for %s (%s), supported metrics are: %s", metricName, resourceType, resourceName, strings.Join(alarmMetricNames[resourceType], ", "))
+}
@@ -0,0 +1,321 @@
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAlarm_basic(t *testing.T) {
+	rName := "awslightsail_alarm.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmConfigBasic(lName, "GreaterThanOrEqualToThreshold", 90, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmExists(rName),
+					resource.TestCheckResourceAttr(rName, "name", lName),
+					resource.TestCheckResourceAttr(rName, "monitored_resource_name", lName),
+					resource.TestCheckResourceAttr(rName, "monitored_resource_type", "Instance"),
+					resource.TestCheckResourceAttr(rName, "metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(rName, "comparison_operator", "GreaterThanOrEqualToThreshold"),
+					resource.TestCheckResourceAttr(rName, "threshold", "90"),
+					resource.TestCheckResourceAttr(rName, "evaluation_periods", "2"),
+					resource.TestCheckResourceAttr(rName, "datapoints_to_alarm", "2"),
+					resource.TestCheckResourceAttr(rName, "treat_missing_data", "missing"),
+					resource.TestCheckResourceAttr(rName, "notification_enabled", "true"),
+					resource.TestCheckResourceAttrSet(rName, "arn"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+					resource.TestCheckResourceAttrSet(rName, "period"),
+					resource.TestCheckResourceAttrSet(rName, "state"),
+					resource.TestCheckResourceAttrSet(rName, "statistic"),
+					resource.TestCheckResourceAttrSet(rName, "support_code"),
+					resource.TestCheckResourceAttrSet(rName, "unit"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAlarm_update(t *testing.T) {
+	rName := "awslightsail_alarm.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmConfigBasic(lName, "GreaterThanOrEqualToThreshold", 90, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmExists(rName),
+					resource.TestCheckResourceAttr(rName, "comparison_operator", "GreaterThanOrEqualToThreshold"),
+					resource.TestCheckResourceAttr(rName, "threshold", "90"),
+					resource.TestCheckResourceAttr(rName, "evaluation_periods", "2"),
+				),
+			},
+			{
+				Config: testAccAlarmConfigBasic(lName, "LessThanThreshold", 10, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmExists(rName),
+					resource.TestCheckResourceAttr(rName, "comparison_operator", "LessThanThreshold"),
+					resource.TestCheckResourceAttr(rName, "threshold", "10"),
+					resource.TestCheckResourceAttr(rName, "evaluation_periods", "3"),
+				),
+			},
+			{
+				Config: testAccAlarmConfigNotifications(lName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmExists(rName),
+					resource.TestCheckResourceAttr(rName, "datapoints_to_alarm", "1"),
+					resource.TestCheckResourceAttr(rName, "treat_missing_data", "breaching"),
+					resource.TestCheckResourceAttr(rName, "contact_protocols.#", "1"),
+					resource.TestCheckTypeSetElemAttr(rName, "contact_protocols.*", "Email"),
+					resource.TestCheckResourceAttr(rName, "notification_triggers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(rName, "notification_triggers.*", "ALARM"),
+					resource.TestCheckTypeSetElemAttr(rName, "notification_triggers.*", "OK"),
+					resource.TestCheckResourceAttr(rName, "notification_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAlarm_InvalidMetricName(t *testing.T) {
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAlarmConfigMetricName(lName, "DatabaseConnections"),
+				ExpectError: regexp.MustCompile(`metric_name "DatabaseConnections" is not supported for Instance`),
+			},
+		},
+	})
+}
+
+func TestAccAlarm_TestTrigger(t *testing.T) {
+	rName := "awslightsail_alarm.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmConfigTestTrigger(lName, "ALARM", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmExists(rName),
+					resource.TestCheckResourceAttr(rName, "test_state", "ALARM"),
+					resource.TestCheckResourceAttr(rName, "test_trigger.%", "1"),
+				),
+			},
+			{
+				Config: testAccAlarmConfigTestTrigger(lName, "OK", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmExists(rName),
+					resource.TestCheckResourceAttr(rName, "test_state", "OK"),
+				),
+			},
+			{
+				Config: testAccAlarmConfigTestTrigger(lName, "INSUFFICIENT_DATA", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmExists(rName),
+					resource.TestCheckResourceAttr(rName, "test_state", "INSUFFICIENT_DATA"),
+					resource.TestCheckResourceAttr(rName, "test_trigger.run", "2"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"test_state", "test_trigger"},
+			},
+		},
+	})
+}
+
+func TestAccAlarm_disappears(t *testing.T) {
+	rName := "awslightsail_alarm.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	testDestroy := func(*terraform.State) error {
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+		_, err := conn.DeleteAlarm(context.TODO(), &lightsail.DeleteAlarmInput{
+			AlarmName: aws.String(lName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error deleting Lightsail Alarm in disappear test")
+		}
+
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmConfigBasic(lName, "GreaterThanOrEqualToThreshold", 90, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmExists(rName),
+					testDestroy,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAlarmExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Alarm ID is set")
+		}
+
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+		resp, err := conn.GetAlarms(context.TODO(), &lightsail.GetAlarmsInput{
+			AlarmName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || len(resp.Alarms) == 0 {
+			return fmt.Errorf("Alarm (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAlarmDestroy(s *terraform.State) error {
+	conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "awslightsail_alarm" {
+			continue
+		}
+
+		resp, err := conn.GetAlarms(context.TODO(), &lightsail.GetAlarmsInput{
+			AlarmName: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil && len(resp.Alarms) > 0 {
+			return fmt.Errorf("Lightsail Alarm %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return testAccCheckInstanceDestroy(s)
+}
+
+func testAccAlarmConfigBase(lName string) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_instance" "test" {
+  name              = %[1]q
+  availability_zone = data.awslightsail_availability_zones.all.names[0]
+  blueprint_id      = "amazon_linux"
+  bundle_id         = "nano_1_0"
+}
+`, lName)
+}
+
+func testAccAlarmConfigBasic(lName, comparisonOperator string, threshold, evaluationPeriods int) string {
+	return testAccAlarmConfigBase(lName) + fmt.Sprintf(`
+resource "awslightsail_alarm" "test" {
+  name                    = %[1]q
+  monitored_resource_name = awslightsail_instance.test.name
+  metric_name             = "CPUUtilization"
+  comparison_operator     = %[2]q
+  threshold               = %[3]d
+  evaluation_periods      = %[4]d
+}
+`, lName, comparisonOperator, threshold, evaluationPeriods)
+}
+
+func testAccAlarmConfigNotifications(lName string) string {
+	return testAccAlarmConfigBase(lName) + fmt.Sprintf(`
+resource "awslightsail_alarm" "test" {
+  name                    = %[1]q
+  monitored_resource_name = awslightsail_instance.test.name
+  metric_name             = "CPUUtilization"
+  comparison_operator     = "LessThanThreshold"
+  threshold               = 10
+  evaluation_periods      = 3
+  datapoints_to_alarm     = 1
+  treat_missing_data      = "breaching"
+  contact_protocols       = ["Email"]
+  notification_triggers   = ["ALARM", "OK"]
+  notification_enabled    = false
+}
+`, lName)
+}
+
+func testAccAlarmConfigMetricName(lName, metricName string) string {
+	return testAccAlarmConfigBase(lName) + fmt.Sprintf(`
+resource "awslightsail_alarm" "test" {
+  name                    = %[1]q
+  monitored_resource_name = awslightsail_instance.test.name
+  metric_name             = %[2]q
+  comparison_operator     = "GreaterThanOrEqualToThreshold"
+  threshold               = 1
+  evaluation_periods      = 1
+}
+`, lName, metricName)
+}
+
+func testAccAlarmConfigTestTrigger(lName, testState, run string) string {
+	return testAccAlarmConfigBase(lName) + fmt.Sprintf(`
+resource "awslightsail_alarm" "test" {
+  name                    = %[1]q
+  monitored_resource_name = awslightsail_instance.test.name
+  metric_name             = "StatusCheckFailed"
+  comparison_operator     = "GreaterThanOrEqualToThreshold"
+  threshold               = 1
+  evaluation_periods      = 1
+  contact_protocols       = ["Email"]
+  notification_triggers   = ["ALARM", "OK", "INSUFFICIENT_DATA"]
+
+  test_state = %[2]q
+  test_trigger = {
+    run = %[3]q
+  }
+}
+`, lName, testState, run)
+}
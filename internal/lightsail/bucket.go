@@ -2,18 +2,20 @@ package lightsail
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
-	"github.com/aws/smithy-go"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/tftags"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/verify"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceBucket() *schema.Resource {
@@ -23,7 +25,7 @@ func ResourceBucket() *schema.Resource {
 		Update: resourceBucketUpdate,
 		Delete: resourceBucketDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceBucketImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,6 +43,114 @@ func ResourceBucket() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"access_rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"get_object": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"public",
+								"private",
+							}, false),
+						},
+						"allow_public_overrides": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"readonly_access_accounts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{12}$`), "must be a 12 digit AWS account ID"),
+				},
+				Set: schema.HashString,
+			},
+			"access_log_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"cors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_origins": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"allowed_methods": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"GET",
+									"PUT",
+									"POST",
+									"DELETE",
+									"HEAD",
+								}, false),
+							},
+							Set: schema.HashString,
+						},
+						"allowed_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"expose_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"max_age_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"force_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			// additional info returned from the API
 			"arn": {
 				Type:     schema.TypeString,
@@ -98,6 +208,39 @@ func resourceBucketCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("name").(string))
 
+	// Access rules, read-only accounts, access logging and CORS can not be passed on creation.
+	// Forcing an update of the values after creation if any of them are configured.
+	updateReq := lightsail.UpdateBucketInput{
+		BucketName: aws.String(d.Id()),
+	}
+	requestUpdate := false
+
+	if v, ok := d.GetOk("access_rules"); ok {
+		updateReq.AccessRules = expandBucketAccessRules(v.([]interface{}))
+		requestUpdate = true
+	}
+
+	if v, ok := d.GetOk("readonly_access_accounts"); ok {
+		updateReq.ReadonlyAccessAccounts = expandStringSet(v.(*schema.Set))
+		requestUpdate = true
+	}
+
+	if v, ok := d.GetOk("access_log_config"); ok {
+		updateReq.AccessLogConfig = expandBucketAccessLogConfig(v.([]interface{}))
+		requestUpdate = true
+	}
+
+	if v, ok := d.GetOk("cors"); ok {
+		updateReq.Cors = expandBucketCors(v.([]interface{}))
+		requestUpdate = true
+	}
+
+	if requestUpdate {
+		if err := updateBucket(conn, &updateReq); err != nil {
+			return err
+		}
+	}
+
 	return resourceBucketRead(d, meta)
 }
 
@@ -107,18 +250,20 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	resp, err := conn.GetBuckets(context.TODO(), &lightsail.GetBucketsInput{
-		BucketName: aws.String(d.Id()),
+		BucketName:  aws.String(d.Id()),
+		IncludeCors: aws.Bool(true),
 	})
 
-	if err != nil {
-		var oe *smithy.OperationError
-		if errors.As(err, &oe) {
-			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
-		}
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Bucket (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Bucket (%s): %w", d.Id(), err)
+	}
+
 	b := resp.Buckets[0]
 
 	d.Set("arn", b.Arn)
@@ -134,6 +279,22 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("versioning_enabled", false)
 	}
 
+	if err := d.Set("access_rules", flattenBucketAccessRules(b.AccessRules, len(d.Get("access_rules").([]interface{})) > 0)); err != nil {
+		return fmt.Errorf("error setting access_rules: %w", err)
+	}
+
+	if err := d.Set("readonly_access_accounts", b.ReadonlyAccessAccounts); err != nil {
+		return fmt.Errorf("error setting readonly_access_accounts: %w", err)
+	}
+
+	if err := d.Set("access_log_config", flattenBucketAccessLogConfig(b.AccessLogConfig)); err != nil {
+		return fmt.Errorf("error setting access_log_config: %w", err)
+	}
+
+	if err := d.Set("cors", flattenBucketCors(b.Cors)); err != nil {
+		return fmt.Errorf("error setting cors: %w", err)
+	}
+
 	tags := KeyValueTags(b.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -159,25 +320,43 @@ func resourceBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	req := lightsail.UpdateBucketInput{
+		BucketName: aws.String(d.Id()),
+	}
+	requestUpdate := false
+
 	if d.HasChange("versioning_enabled") {
-		req := lightsail.UpdateBucketInput{
-			BucketName: aws.String(d.Id()),
-		}
 		if d.Get("versioning_enabled").(bool) {
 			req.Versioning = aws.String("Enabled")
 		} else {
 			req.Versioning = aws.String("Suspended")
 		}
-		resp, err := conn.UpdateBucket(context.TODO(), &req)
-		op := resp.Operations[0]
+		requestUpdate = true
+	}
 
-		if err != nil {
-			return err
-		}
+	if d.HasChange("access_rules") {
+		req.AccessRules = expandBucketAccessRules(d.Get("access_rules").([]interface{}))
+		requestUpdate = true
+	}
 
-		err = waitLightsailOperation(conn, op.Id)
-		if err != nil {
-			return fmt.Errorf("Error waiting for Bucket (%s) to become ready: %s", d.Id(), err)
+	if d.HasChange("readonly_access_accounts") {
+		req.ReadonlyAccessAccounts = expandStringSet(d.Get("readonly_access_accounts").(*schema.Set))
+		requestUpdate = true
+	}
+
+	if d.HasChange("access_log_config") {
+		req.AccessLogConfig = expandBucketAccessLogConfig(d.Get("access_log_config").([]interface{}))
+		requestUpdate = true
+	}
+
+	if d.HasChange("cors") {
+		req.Cors = expandBucketCors(d.Get("cors").([]interface{}))
+		requestUpdate = true
+	}
+
+	if requestUpdate {
+		if err := updateBucket(conn, &req); err != nil {
+			return err
 		}
 	}
 
@@ -204,9 +383,188 @@ func resourceBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceBucketDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LightsailConn
-	_, err := conn.DeleteBucket(context.TODO(), &lightsail.DeleteBucketInput{
-		BucketName: aws.String(d.Id()),
+
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Lightsail Bucket (%s) has deletion_protection enabled. Set deletion_protection to false and apply the change before destroying the bucket", d.Id())
+	}
+
+	resp, err := conn.DeleteBucket(context.TODO(), &lightsail.DeleteBucketInput{
+		BucketName:  aws.String(d.Id()),
+		ForceDelete: aws.Bool(d.Get("force_delete").(bool)),
 	})
 
-	return err
+	if err != nil {
+		if !d.Get("force_delete").(bool) {
+			return fmt.Errorf("error deleting Lightsail Bucket (%s), set force_delete to true to delete a bucket that contains objects: %w", d.Id(), err)
+		}
+		return fmt.Errorf("error deleting Lightsail Bucket (%s): %w", d.Id(), err)
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for DeleteBucket request")
+	}
+
+	op := resp.Operations[0]
+
+	err = waitLightsailOperation(conn, op.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Bucket (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBucketImport(
+	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Neither force_delete nor deletion_protection can be fetched from any
+	// API call, so we need to default them to match the schema defaults
+	d.Set("force_delete", false)
+	d.Set("deletion_protection", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateBucket(conn *lightsail.Client, req *lightsail.UpdateBucketInput) error {
+	bucketName := aws.ToString(req.BucketName)
+
+	resp, err := conn.UpdateBucket(context.TODO(), req)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for UpdateBucket request")
+	}
+
+	for _, op := range resp.Operations {
+		err = waitLightsailOperation(conn, op.Id)
+		if err != nil {
+			return fmt.Errorf("Error waiting for Bucket (%s) to become ready: %s", bucketName, err)
+		}
+	}
+
+	return nil
+}
+
+// expandBucketAccessRules returns the access rules of the bucket, no rules reset the bucket to the
+// default private access rules
+func expandBucketAccessRules(rawAccessRules []interface{}) *types.AccessRules {
+	if len(rawAccessRules) == 0 || rawAccessRules[0] == nil {
+		return &types.AccessRules{
+			GetObject:            types.AccessTypePrivate,
+			AllowPublicOverrides: aws.Bool(false),
+		}
+	}
+
+	m := rawAccessRules[0].(map[string]interface{})
+
+	accessRules := &types.AccessRules{
+		GetObject:            types.AccessType(m["get_object"].(string)),
+		AllowPublicOverrides: aws.Bool(m["allow_public_overrides"].(bool)),
+	}
+
+	return accessRules
+}
+
+// flattenBucketAccessRules returns no rules for the default private access rules, unless they are set
+// in the configuration
+func flattenBucketAccessRules(accessRules *types.AccessRules, configured bool) []interface{} {
+	if accessRules == nil {
+		return []interface{}{}
+	}
+
+	if !configured && accessRules.GetObject == types.AccessTypePrivate && !aws.ToBool(accessRules.AllowPublicOverrides) {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"get_object":             string(accessRules.GetObject),
+		"allow_public_overrides": aws.ToBool(accessRules.AllowPublicOverrides),
+	}
+
+	return []interface{}{m}
+}
+
+func expandBucketAccessLogConfig(rawAccessLogConfig []interface{}) *types.BucketAccessLogConfig {
+	if len(rawAccessLogConfig) == 0 || rawAccessLogConfig[0] == nil {
+		return &types.BucketAccessLogConfig{
+			Enabled: aws.Bool(false),
+		}
+	}
+
+	m := rawAccessLogConfig[0].(map[string]interface{})
+
+	accessLogConfig := &types.BucketAccessLogConfig{
+		Enabled:     aws.Bool(true),
+		Destination: aws.String(m["destination"].(string)),
+	}
+
+	if v, ok := m["prefix"].(string); ok && v != "" {
+		accessLogConfig.Prefix = aws.String(v)
+	}
+
+	return accessLogConfig
+}
+
+func flattenBucketAccessLogConfig(accessLogConfig *types.BucketAccessLogConfig) []interface{} {
+	if accessLogConfig == nil || !aws.ToBool(accessLogConfig.Enabled) {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"destination": aws.ToString(accessLogConfig.Destination),
+		"prefix":      aws.ToString(accessLogConfig.Prefix),
+	}
+
+	return []interface{}{m}
+}
+
+// expandBucketCors returns the CORS configuration of the bucket, no rules remove the configuration
+func expandBucketCors(tfList []interface{}) *types.BucketCorsConfig {
+	cors := &types.BucketCorsConfig{
+		Rules: []types.BucketCorsRule{},
+	}
+
+	for _, tfMapRaw := range tfList {
+		m, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule := types.BucketCorsRule{
+			AllowedOrigins: expandStringSet(m["allowed_origins"].(*schema.Set)),
+			AllowedMethods: expandStringSet(m["allowed_methods"].(*schema.Set)),
+			AllowedHeaders: expandStringSet(m["allowed_headers"].(*schema.Set)),
+			ExposeHeaders:  expandStringSet(m["expose_headers"].(*schema.Set)),
+		}
+
+		if v, ok := m["max_age_seconds"].(int); ok && v > 0 {
+			rule.MaxAgeSeconds = aws.Int32(int32(v))
+		}
+
+		cors.Rules = append(cors.Rules, rule)
+	}
+
+	return cors
+}
+
+func flattenBucketCors(cors *types.BucketCorsConfig) []interface{} {
+	if cors == nil {
+		return []interface{}{}
+	}
+
+	tfList := make([]interface{}, 0, len(cors.Rules))
+
+	for _, rule := range cors.Rules {
+		m := map[string]interface{}{
+			"allowed_origins": rule.AllowedOrigins,
+			"allowed_methods": rule.AllowedMethods,
+			"allowed_headers": rule.AllowedHeaders,
+			"expose_headers":  rule.ExposeHeaders,
+			"max_age_seconds": int(aws.ToInt32(rule.MaxAgeSeconds)),
+		}
+
+		tfList = append(tfList, m)
+	}
+
+	return tfList
 }
@@ -0,0 +1,214 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/helper/encryption"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceBucketAccessKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketAccessKeyCreate,
+		Read:   resourceBucketAccessKeyRead,
+		Delete: resourceBucketAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// optional fields
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// additional info returned from the API
+			"access_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			// fields returned from CreateBucketAccessKey
+			"secret_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			// encrypted fields if pgp_key is given
+			"encrypted_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_secret_access_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBucketAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	bucketName := d.Get("bucket_name").(string)
+
+	resp, err := conn.CreateBucketAccessKey(context.TODO(), &lightsail.CreateBucketAccessKeyInput{
+		BucketName: aws.String(bucketName),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for CreateBucketAccessKey request")
+	}
+
+	if resp.AccessKey == nil {
+		return fmt.Errorf("No AccessKey information found for CreateBucketAccessKey response")
+	}
+
+	op := resp.Operations[0]
+
+	// Generate an ID
+	vars := []string{
+		bucketName,
+		aws.ToString(resp.AccessKey.AccessKeyId),
+	}
+
+	d.SetId(strings.Join(vars, ","))
+
+	// The secret access key is only available in the response from
+	// CreateBucketAccessKey. Encrypt it if a pgp_key is given, else store
+	// the secret in state.
+	pgpKey, err := encryption.RetrieveGPGKey(d.Get("pgp_key").(string))
+	if err != nil {
+		return err
+	}
+	if pgpKey != "" {
+		fingerprint, encrypted, err := encryption.EncryptValue(pgpKey, aws.ToString(resp.AccessKey.SecretAccessKey), "Lightsail Bucket Secret Access Key")
+		if err != nil {
+			return err
+		}
+
+		d.Set("encrypted_fingerprint", fingerprint)
+		d.Set("encrypted_secret_access_key", encrypted)
+	} else {
+		d.Set("secret_access_key", resp.AccessKey.SecretAccessKey)
+	}
+
+	err = waitLightsailOperation(conn, op.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Bucket Access Key (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceBucketAccessKeyRead(d, meta)
+}
+
+func resourceBucketAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	bucketName, accessKeyId, err := expandBucketAccessKeyId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetBucketAccessKeys(context.TODO(), &lightsail.GetBucketAccessKeysInput{
+		BucketName: aws.String(bucketName),
+	})
+
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Bucket Access Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Bucket Access Key (%s): %w", d.Id(), err)
+	}
+
+	var entry types.AccessKey
+	entryExists := false
+
+	for _, n := range resp.AccessKeys {
+		if accessKeyId == aws.ToString(n.AccessKeyId) {
+			entry = n
+			entryExists = true
+			break
+		}
+	}
+
+	if !entryExists {
+		log.Printf("[WARN] Lightsail Bucket Access Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket_name", bucketName)
+	d.Set("access_key_id", entry.AccessKeyId)
+	d.Set("created_at", entry.CreatedAt.Format(time.RFC3339))
+	d.Set("status", entry.Status)
+
+	return nil
+}
+
+func resourceBucketAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	bucketName, accessKeyId, err := expandBucketAccessKeyId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DeleteBucketAccessKey(context.TODO(), &lightsail.DeleteBucketAccessKeyInput{
+		BucketName:  aws.String(bucketName),
+		AccessKeyId: aws.String(accessKeyId),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	op := resp.Operations[0]
+
+	err = waitLightsailOperation(conn, op.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Bucket Access Key (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketAccessKeyId(id string) (string, string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected BUCKET_NAME,ACCESS_KEY_ID", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
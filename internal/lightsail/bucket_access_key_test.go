@@ -0,0 +1,157 @@
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBucketAccessKey_basic(t *testing.T) {
+	rName := "awslightsail_bucket_access_key.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckBucketAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketAccessKeyConfigBasic(lName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketAccessKeyExists(rName),
+					resource.TestCheckResourceAttr(rName, "bucket_name", lName),
+					resource.TestCheckResourceAttrSet(rName, "access_key_id"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+					resource.TestCheckResourceAttrSet(rName, "secret_access_key"),
+					resource.TestCheckResourceAttr(rName, "status", "Active"),
+					resource.TestCheckNoResourceAttr(rName, "encrypted_fingerprint"),
+					resource.TestCheckNoResourceAttr(rName, "encrypted_secret_access_key"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_access_key"},
+			},
+		},
+	})
+}
+
+func TestAccBucketAccessKey_encrypted(t *testing.T) {
+	rName := "awslightsail_bucket_access_key.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckBucketAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketAccessKeyConfigEncrypted(lName, testKeyPairPubKey1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketAccessKeyExists(rName),
+					resource.TestCheckResourceAttrSet(rName, "access_key_id"),
+					resource.TestCheckResourceAttrSet(rName, "encrypted_fingerprint"),
+					resource.TestCheckResourceAttrSet(rName, "encrypted_secret_access_key"),
+					resource.TestCheckNoResourceAttr(rName, "secret_access_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketAccessKeyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Bucket Access Key ID is set")
+		}
+
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+		resp, err := conn.GetBucketAccessKeys(context.TODO(), &lightsail.GetBucketAccessKeysInput{
+			BucketName: aws.String(rs.Primary.Attributes["bucket_name"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, k := range resp.AccessKeys {
+			if strings.HasSuffix(rs.Primary.ID, ","+aws.ToString(k.AccessKeyId)) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Bucket Access Key (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckBucketAccessKeyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "awslightsail_bucket_access_key" {
+			continue
+		}
+
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+		resp, err := conn.GetBucketAccessKeys(context.TODO(), &lightsail.GetBucketAccessKeysInput{
+			BucketName: aws.String(rs.Primary.Attributes["bucket_name"]),
+		})
+
+		// The bucket is destroyed alongside the key
+		if err != nil {
+			return nil
+		}
+
+		for _, k := range resp.AccessKeys {
+			if strings.HasSuffix(rs.Primary.ID, ","+aws.ToString(k.AccessKeyId)) {
+				return fmt.Errorf("Bucket Access Key %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccBucketAccessKeyConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_bucket" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+}
+
+resource "awslightsail_bucket_access_key" "test" {
+  bucket_name = awslightsail_bucket.test.id
+}
+`, rName)
+}
+
+func testAccBucketAccessKeyConfigEncrypted(rName, key string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_bucket" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+}
+
+resource "awslightsail_bucket_access_key" "test" {
+  bucket_name = awslightsail_bucket.test.id
+  pgp_key     = <<EOF
+%[2]s
+EOF
+}
+`, rName, key)
+}
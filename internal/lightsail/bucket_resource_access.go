@@ -0,0 +1,155 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceBucketResourceAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketResourceAccessCreate,
+		Read:   resourceBucketResourceAccessRead,
+		Delete: resourceBucketResourceAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBucketResourceAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	bucketName := d.Get("bucket_name").(string)
+	resourceName := d.Get("resource_name").(string)
+
+	err := setBucketResourceAccess(conn, bucketName, resourceName, types.ResourceBucketAccessAllow)
+	if err != nil {
+		return err
+	}
+
+	// Generate an ID
+	vars := []string{
+		bucketName,
+		resourceName,
+	}
+
+	d.SetId(strings.Join(vars, ","))
+
+	return resourceBucketResourceAccessRead(d, meta)
+}
+
+func resourceBucketResourceAccessRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	bucketName, resourceName, err := expandBucketResourceAccessId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetBuckets(context.TODO(), &lightsail.GetBucketsInput{
+		BucketName:                aws.String(bucketName),
+		IncludeConnectedResources: aws.Bool(true),
+	})
+
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Bucket Resource Access (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Bucket Resource Access (%s): %w", d.Id(), err)
+	}
+
+	if len(resp.Buckets) == 0 {
+		log.Printf("[WARN] Lightsail Bucket (%s) not found, removing Resource Access (%s) from state", bucketName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	entryExists := false
+
+	for _, n := range resp.Buckets[0].ResourcesReceivingAccess {
+		if resourceName == aws.ToString(n.Name) {
+			entryExists = true
+			break
+		}
+	}
+
+	if !entryExists {
+		log.Printf("[WARN] Lightsail Resource (%s) no longer has access to Bucket (%s), removing from state", resourceName, bucketName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket_name", bucketName)
+	d.Set("resource_name", resourceName)
+
+	return nil
+}
+
+func resourceBucketResourceAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	bucketName, resourceName, err := expandBucketResourceAccessId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	return setBucketResourceAccess(conn, bucketName, resourceName, types.ResourceBucketAccessDeny)
+}
+
+func setBucketResourceAccess(conn *lightsail.Client, bucketName string, resourceName string, access types.ResourceBucketAccess) error {
+	resp, err := conn.SetResourceAccessForBucket(context.TODO(), &lightsail.SetResourceAccessForBucketInput{
+		BucketName:   aws.String(bucketName),
+		ResourceName: aws.String(resourceName),
+		Access:       access,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for SetResourceAccessForBucket request")
+	}
+
+	op := resp.Operations[0]
+
+	err = waitLightsailOperation(conn, op.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Bucket (%s) Resource Access (%s) to be set to %s: %s", bucketName, resourceName, access, err)
+	}
+
+	return nil
+}
+
+func expandBucketResourceAccessId(id string) (string, string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected BUCKET_NAME,RESOURCE_NAME", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
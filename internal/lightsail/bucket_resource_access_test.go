@@ -0,0 +1,132 @@
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBucketResourceAccess_basic(t *testing.T) {
+	rName := "awslightsail_bucket_resource_access.test"
+	bName := acctest.RandomWithPrefix("tf-acc-test")
+	liName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckBucketResourceAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketResourceAccessConfigBasic(bName, liName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketResourceAccessExists(rName),
+					resource.TestCheckResourceAttr(rName, "bucket_name", bName),
+					resource.TestCheckResourceAttr(rName, "resource_name", liName),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBucketResourceAccessExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Bucket Resource Access ID is set")
+		}
+
+		idParts := strings.Split(rs.Primary.ID, ",")
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+		resp, err := conn.GetBuckets(context.TODO(), &lightsail.GetBucketsInput{
+			BucketName:                aws.String(idParts[0]),
+			IncludeConnectedResources: aws.Bool(true),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Buckets) == 0 {
+			return fmt.Errorf("Bucket (%s) not found", idParts[0])
+		}
+
+		for _, r := range resp.Buckets[0].ResourcesReceivingAccess {
+			if aws.ToString(r.Name) == idParts[1] {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Bucket Resource Access (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckBucketResourceAccessDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "awslightsail_bucket_resource_access" {
+			continue
+		}
+
+		idParts := strings.Split(rs.Primary.ID, ",")
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+		resp, err := conn.GetBuckets(context.TODO(), &lightsail.GetBucketsInput{
+			BucketName:                aws.String(idParts[0]),
+			IncludeConnectedResources: aws.Bool(true),
+		})
+
+		// The bucket is destroyed alongside the resource access
+		if err != nil || len(resp.Buckets) == 0 {
+			return nil
+		}
+
+		for _, r := range resp.Buckets[0].ResourcesReceivingAccess {
+			if aws.ToString(r.Name) == idParts[1] {
+				return fmt.Errorf("Bucket Resource Access %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccBucketResourceAccessConfigBasic(bName string, liName string) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_bucket" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+}
+
+resource "awslightsail_instance" "test" {
+  name              = %[2]q
+  availability_zone = data.awslightsail_availability_zones.all.names[0]
+  blueprint_id      = "amazon_linux"
+  bundle_id         = "nano_1_0"
+}
+
+resource "awslightsail_bucket_resource_access" "test" {
+  bucket_name   = awslightsail_bucket.test.id
+  resource_name = awslightsail_instance.test.id
+}
+`, bName, liName)
+}
@@ -5,10 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/smithy-go"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
@@ -21,12 +29,17 @@ import (
 func TestAccBucket_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Bucket": {
-			"basic":             testAccBucket_basic,
-			"Name":              testAccBucket_Name,
-			"VersioningEnabled": testAccBucket_VersioningEnabled,
-			"BundleId":          testAccBucket_BundleId,
-			"Tags":              testAccBucket_Tags,
-			"disappears":        testAccBucket_disappears,
+			"basic":              testAccBucket_basic,
+			"Name":               testAccBucket_Name,
+			"VersioningEnabled":  testAccBucket_VersioningEnabled,
+			"BundleId":           testAccBucket_BundleId,
+			"Tags":               testAccBucket_Tags,
+			"AccessRules":        testAccBucket_AccessRules,
+			"AccessLogConfig":    testAccBucket_AccessLogConfig,
+			"Cors":               testAccBucket_Cors,
+			"ForceDelete":        testAccBucket_ForceDelete,
+			"DeletionProtection": testAccBucket_DeletionProtection,
+			"disappears":         testAccBucket_disappears,
 		},
 	}
 
@@ -196,6 +209,206 @@ func testAccBucket_Tags(t *testing.T) {
 	})
 }
 
+func testAccBucket_AccessRules(t *testing.T) {
+	rName := "awslightsail_bucket.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testhelper.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigAccessRules(lName, "public", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					resource.TestCheckResourceAttr(rName, "access_rules.#", "1"),
+					resource.TestCheckResourceAttr(rName, "access_rules.0.get_object", "public"),
+					resource.TestCheckResourceAttr(rName, "access_rules.0.allow_public_overrides", "false"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketConfigAccessRules(lName, "private", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					resource.TestCheckResourceAttr(rName, "access_rules.#", "1"),
+					resource.TestCheckResourceAttr(rName, "access_rules.0.get_object", "private"),
+					resource.TestCheckResourceAttr(rName, "access_rules.0.allow_public_overrides", "true"),
+				),
+			},
+			{
+				Config: testAccBucketConfigAccessRules(lName, "public", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					resource.TestCheckResourceAttr(rName, "access_rules.0.get_object", "public"),
+				),
+			},
+			{
+				Config: testAccBucketConfigBasic(lName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					resource.TestCheckResourceAttr(rName, "access_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucket_AccessLogConfig(t *testing.T) {
+	rName := "awslightsail_bucket.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+	lName2 := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testhelper.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigAccessLogConfig(lName, lName2, "logs/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					resource.TestCheckResourceAttr(rName, "access_log_config.#", "1"),
+					resource.TestCheckResourceAttr(rName, "access_log_config.0.destination", lName2),
+					resource.TestCheckResourceAttr(rName, "access_log_config.0.prefix", "logs/"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketConfigAccessLogConfigDisabled(lName, lName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					resource.TestCheckResourceAttr(rName, "access_log_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucket_Cors(t *testing.T) {
+	rName := "awslightsail_bucket.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testhelper.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigCors(lName, "https://www.example.com", 3000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					resource.TestCheckResourceAttr(rName, "cors.#", "1"),
+					resource.TestCheckTypeSetElemAttr(rName, "cors.0.allowed_origins.*", "https://www.example.com"),
+					resource.TestCheckResourceAttr(rName, "cors.0.allowed_methods.#", "2"),
+					resource.TestCheckTypeSetElemAttr(rName, "cors.0.allowed_methods.*", "GET"),
+					resource.TestCheckTypeSetElemAttr(rName, "cors.0.allowed_methods.*", "HEAD"),
+					resource.TestCheckTypeSetElemAttr(rName, "cors.0.allowed_headers.*", "*"),
+					resource.TestCheckTypeSetElemAttr(rName, "cors.0.expose_headers.*", "ETag"),
+					resource.TestCheckResourceAttr(rName, "cors.0.max_age_seconds", "3000"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketConfigCors(lName, "https://assets.example.com", 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					resource.TestCheckResourceAttr(rName, "cors.#", "1"),
+					resource.TestCheckTypeSetElemAttr(rName, "cors.0.allowed_origins.*", "https://assets.example.com"),
+					resource.TestCheckResourceAttr(rName, "cors.0.max_age_seconds", "600"),
+				),
+			},
+			{
+				Config: testAccBucketConfigBasic(lName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					resource.TestCheckResourceAttr(rName, "cors.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucket_ForceDelete(t *testing.T) {
+	rName := "awslightsail_bucket.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigForceDelete(lName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					testAccCheckBucketPutObject(rName, "awslightsail_bucket_access_key.test"),
+					resource.TestCheckResourceAttr(rName, "force_delete", "false"),
+				),
+			},
+			{
+				// A bucket containing objects can not be deleted without force_delete
+				Config:      testAccBucketConfigForceDelete(lName, false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`set force_delete to true to delete a bucket that contains objects`),
+			},
+			{
+				// The object is still present, the final destroy deletes it along with the bucket
+				Config: testAccBucketConfigForceDelete(lName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					resource.TestCheckResourceAttr(rName, "force_delete", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucket_DeletionProtection(t *testing.T) {
+	rName := "awslightsail_bucket.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfigDeletionProtection(lName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					resource.TestCheckResourceAttr(rName, "deletion_protection", "true"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"deletion_protection",
+				},
+			},
+			{
+				Config:      testAccBucketConfigDeletionProtection(lName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`has deletion_protection enabled`),
+			},
+			{
+				Config: testAccBucketConfigDeletionProtection(lName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(rName),
+					resource.TestCheckResourceAttr(rName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccBucket_disappears(t *testing.T) {
 	rName := "awslightsail_bucket.test"
 	lName := acctest.RandomWithPrefix("tf-acc-test")
@@ -265,6 +478,50 @@ func testAccCheckBucketExists(n string) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckBucketPutObject uploads an object to the bucket through the S3 compatible API, using the given access key
+func testAccCheckBucketPutObject(n, accessKey string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		ak, ok := s.RootModule().Resources[accessKey]
+		if !ok {
+			return fmt.Errorf("Not found: %s", accessKey)
+		}
+
+		sess, err := session.NewSession(&awsv1.Config{
+			Region:      awsv1.String(testhelper.GetProvider().Meta().(*conns.AWSClient).Region),
+			Credentials: credentials.NewStaticCredentials(ak.Primary.Attributes["access_key_id"], ak.Primary.Attributes["secret_access_key"], ""),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		// New access keys take a moment before S3 accepts them
+		return resource.Retry(2*time.Minute, func() *resource.RetryError {
+			_, err := s3.New(sess).PutObject(&s3.PutObjectInput{
+				Bucket: awsv1.String(rs.Primary.ID),
+				Key:    awsv1.String("tf-acc-test.txt"),
+				Body:   strings.NewReader("tf-acc-test"),
+			})
+
+			var awsErr awserr.Error
+			if errors.As(err, &awsErr) && awsErr.Code() == "InvalidAccessKeyId" {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			return nil
+		})
+	}
+}
+
 func testAccCheckBucketDestroy(s *terraform.State) error {
 
 	for _, rs := range s.RootModule().Resources {
@@ -350,3 +607,88 @@ resource "awslightsail_bucket" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccBucketConfigAccessRules(rName string, getObject string, allowPublicOverrides bool) string {
+	return fmt.Sprintf(`
+resource "awslightsail_bucket" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+  access_rules {
+    get_object             = %[2]q
+    allow_public_overrides = %[3]t
+  }
+}
+`, rName, getObject, allowPublicOverrides)
+}
+
+func testAccBucketConfigAccessLogConfig(rName string, rDestination string, rPrefix string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_bucket" "destination" {
+  name      = %[2]q
+  bundle_id = "small_1_0"
+}
+
+resource "awslightsail_bucket" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+  access_log_config {
+    destination = awslightsail_bucket.destination.id
+    prefix      = %[3]q
+  }
+}
+`, rName, rDestination, rPrefix)
+}
+
+func testAccBucketConfigAccessLogConfigDisabled(rName string, rDestination string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_bucket" "destination" {
+  name      = %[2]q
+  bundle_id = "small_1_0"
+}
+
+resource "awslightsail_bucket" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+}
+`, rName, rDestination)
+}
+
+func testAccBucketConfigCors(rName string, origin string, maxAge int) string {
+	return fmt.Sprintf(`
+resource "awslightsail_bucket" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+  cors {
+    allowed_origins = [%[2]q]
+    allowed_methods = ["GET", "HEAD"]
+    allowed_headers = ["*"]
+    expose_headers  = ["ETag"]
+    max_age_seconds = %[3]d
+  }
+}
+`, rName, origin, maxAge)
+}
+
+func testAccBucketConfigForceDelete(rName string, rForceDelete bool) string {
+	return fmt.Sprintf(`
+resource "awslightsail_bucket" "test" {
+  name         = %[1]q
+  bundle_id    = "small_1_0"
+  force_delete = %[2]t
+}
+
+resource "awslightsail_bucket_access_key" "test" {
+  bucket_name = awslightsail_bucket.test.name
+}
+`, rName, rForceDelete)
+}
+
+func testAccBucketConfigDeletionProtection(rName string, rDeletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "awslightsail_bucket" "test" {
+  name                = %[1]q
+  bundle_id           = "small_1_0"
+  deletion_protection = %[2]t
+}
+`, rName, rDeletionProtection)
+}
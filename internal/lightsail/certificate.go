@@ -2,7 +2,6 @@ package lightsail
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,9 +10,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
-	"github.com/aws/smithy-go"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/create"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/tftags"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/verify"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -153,15 +152,16 @@ func resourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
 		CertificateName: aws.String(d.Id()),
 	})
 
-	if err != nil {
-		var oe *smithy.OperationError
-		if errors.As(err, &oe) {
-			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
-		}
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Certificate (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Certificate (%s): %w", d.Id(), err)
+	}
+
 	if len(resp.Certificates) == 0 {
 		log.Printf("[WARN] Lightsail Certificate (%s) not found removing from state", d.Id())
 		d.SetId("")
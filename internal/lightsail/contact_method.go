@@ -2,15 +2,15 @@ package lightsail
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
-	"github.com/aws/smithy-go"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -19,9 +19,10 @@ func ResourceContactMethod() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceContactMethodCreate,
 		Read:   resourceContactMethodRead,
+		Update: resourceContactMethodUpdate,
 		Delete: resourceContactMethodDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceContactMethodImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,6 +42,28 @@ func ResourceContactMethod() *schema.Resource {
 					"Email",
 				}, false),
 			},
+			"send_verification": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to send a new verification request to an Email contact method that has not been verified yet.",
+			},
+			"wait_for_verification": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "How long to wait for the contact method to be verified during creation, such as 15m.",
+				ValidateFunc: validateContactMethodDuration,
+			},
+			// additional info returned from the API
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the contact method, such as PendingVerification, Valid or Invalid.",
+			},
 		},
 	}
 }
@@ -66,9 +89,24 @@ func resourceContactMethodCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(d.Get("protocol").(string))
 
+	// Lightsail sends the first verification request when the contact method is created
+	if v, ok := d.GetOk("wait_for_verification"); ok {
+		if err := waitContactMethodVerification(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceContactMethodRead(d, meta)
 }
 
+func resourceContactMethodImport(
+	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// send_verification can not be fetched from any API call, so we need to
+	// default it to match the schema default
+	d.Set("send_verification", false)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceContactMethodRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LightsailConn
 
@@ -78,15 +116,16 @@ func resourceContactMethodRead(d *schema.ResourceData, meta interface{}) error {
 		Protocols: append(protocols, types.ContactProtocol(d.Id())),
 	})
 
-	if err != nil {
-		var oe *smithy.OperationError
-		if errors.As(err, &oe) {
-			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
-		}
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Contact Method (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Contact Method (%s): %w", d.Id(), err)
+	}
+
 	var entry types.ContactMethod
 	entryExists := false
 
@@ -104,10 +143,30 @@ func resourceContactMethodRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("protocol", entry.Protocol)
 	d.Set("endpoint", aws.ToString(entry.ContactEndpoint))
+	d.Set("arn", entry.Arn)
+	d.Set("status", entry.Status)
 
 	return nil
 }
 
+func resourceContactMethodUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	if d.HasChange("send_verification") && d.Get("send_verification").(bool) && d.Get("status").(string) != ContactMethodStatusValid {
+		if err := sendContactMethodVerification(conn, d.Id()); err != nil {
+			return err
+		}
+
+		if v, ok := d.GetOk("wait_for_verification"); ok {
+			if err := waitContactMethodVerification(conn, d.Id(), v.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceContactMethodRead(d, meta)
+}
+
 func resourceContactMethodDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LightsailConn
 
@@ -128,3 +187,57 @@ func resourceContactMethodDelete(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+func sendContactMethodVerification(conn *lightsail.Client, protocol string) error {
+	if protocol != string(types.ContactMethodVerificationProtocolEmail) {
+		return fmt.Errorf("verification requests can only be sent to Email contact methods, not %s", protocol)
+	}
+
+	resp, err := conn.SendContactMethodVerification(context.TODO(), &lightsail.SendContactMethodVerificationInput{
+		Protocol: types.ContactMethodVerificationProtocolEmail,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error sending Lightsail Contact Method (%s) verification: %w", protocol, err)
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for SendContactMethodVerification request")
+	}
+
+	op := resp.Operations[0]
+
+	err = waitLightsailOperation(conn, op.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Contact Method (%s) verification to be sent: %s", protocol, err)
+	}
+
+	return nil
+}
+
+func waitContactMethodVerification(conn *lightsail.Client, protocol, duration string) error {
+	timeout, err := time.ParseDuration(duration)
+	if err != nil {
+		return err
+	}
+
+	if err := waitContactMethodVerified(conn, protocol, timeout); err != nil {
+		return fmt.Errorf("Error waiting for Contact Method (%s) to be verified: %s", protocol, err)
+	}
+
+	return nil
+}
+
+func validateContactMethodDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid duration such as 15m: %s", k, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration", k))
+	}
+
+	return
+}
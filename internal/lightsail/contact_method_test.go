@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
@@ -19,8 +20,9 @@ import (
 func TestAccContactMethod_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"ContactMethod": {
-			"basic":      testAccContactMethod_basic,
-			"disappears": testAccContactMethod_disappears,
+			"basic":            testAccContactMethod_basic,
+			"disappears":       testAccContactMethod_disappears,
+			"sendVerification": testAccContactMethod_sendVerification,
 		},
 	}
 
@@ -49,8 +51,49 @@ func testAccContactMethod_basic(t *testing.T) {
 				Config: testAccContactMethodConfig_basic(lEndpoint),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckContactMethodExists(rName),
+					resource.TestCheckResourceAttr(rName, "protocol", "Email"),
+					resource.TestCheckResourceAttr(rName, "endpoint", lEndpoint),
+					resource.TestCheckResourceAttr(rName, "status", "PendingVerification"),
+					resource.TestCheckResourceAttrSet(rName, "arn"),
 				),
 			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccContactMethod_sendVerification(t *testing.T) {
+	rName := "awslightsail_contact_method.test"
+	lEndpoint := testhelper.DefaultEmailAddress
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckContactMethodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactMethodConfig_basic(lEndpoint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContactMethodExists(rName),
+					resource.TestCheckResourceAttr(rName, "send_verification", "false"),
+				),
+			},
+			{
+				Config: testAccContactMethodConfig_sendVerification(lEndpoint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContactMethodExists(rName),
+					resource.TestCheckResourceAttr(rName, "send_verification", "true"),
+					resource.TestCheckResourceAttr(rName, "status", "PendingVerification"),
+				),
+			},
+			{
+				Config:      testAccContactMethodConfig_waitForVerification(lEndpoint, "forever"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be a valid duration`),
+			},
 		},
 	})
 }
@@ -176,3 +219,23 @@ resource "awslightsail_contact_method" "test" {
 }
 `, lEndpoint)
 }
+
+func testAccContactMethodConfig_sendVerification(lEndpoint string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_contact_method" "test" {
+  protocol          = "Email"
+  endpoint          = %[1]q
+  send_verification = true
+}
+`, lEndpoint)
+}
+
+func testAccContactMethodConfig_waitForVerification(lEndpoint, timeout string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_contact_method" "test" {
+  protocol              = "Email"
+  endpoint              = %[1]q
+  wait_for_verification = %[2]q
+}
+`, lEndpoint, timeout)
+}
@@ -2,15 +2,15 @@ package lightsail
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"log"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
-	"github.com/aws/smithy-go"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -206,15 +206,16 @@ func resourceContainerDeploymentRead(d *schema.ResourceData, meta interface{}) e
 		},
 	)
 
-	if err != nil {
-		var oe *smithy.OperationError
-		if errors.As(err, &oe) {
-			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
-		}
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Container Deployment (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Container Deployment (%s): %w", d.Id(), err)
+	}
+
 	csd := resp.Deployments[0]
 
 	d.Set("container_service_name", d.Id())
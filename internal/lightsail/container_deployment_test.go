@@ -98,6 +98,11 @@ func testAccContainerDeployment_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(rName, "container.0.port.#", "0"),
 				),
 			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
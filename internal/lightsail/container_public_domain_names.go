@@ -2,13 +2,13 @@ package lightsail
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
-	"github.com/aws/smithy-go"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -102,15 +102,16 @@ func resourceContainerPublicDomainNamesRead(d *schema.ResourceData, meta interfa
 		},
 	)
 
-	if err != nil {
-		var oe *smithy.OperationError
-		if errors.As(err, &oe) {
-			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
-		}
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Container Public Domain Names (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Container Public Domain Names (%s): %w", d.Id(), err)
+	}
+
 	cs := resp.ContainerServices[0]
 
 	d.Set("container_service_name", cs.ContainerServiceName)
@@ -2,15 +2,14 @@ package lightsail
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
-	"github.com/aws/smithy-go"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/tftags"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/verify"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -157,14 +156,15 @@ func resourceContainerServiceRead(d *schema.ResourceData, meta interface{}) erro
 		},
 	)
 
-	if err != nil {
-		var oe *smithy.OperationError
-		if errors.As(err, &oe) {
-			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
-		}
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Container Service (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Container Service (%s): %w", d.Id(), err)
+	}
 	// just look at index 0 because we only looked up 1 container service
 	cs := resp.ContainerServices[0]
 
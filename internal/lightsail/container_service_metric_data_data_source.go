@@ -0,0 +1,31 @@
+package lightsail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceContainerServiceMetricData() *schema.Resource {
+	return dataSourceMetricData("Container Service", "container_service_name", []string{"CPUUtilization", "MemoryUtilization"}, false, getContainerServiceMetricData)
+}
+
+func getContainerServiceMetricData(conn *lightsail.Client, req metricDataRequest) ([]types.MetricDatapoint, error) {
+	resp, err := conn.GetContainerServiceMetricData(context.TODO(), &lightsail.GetContainerServiceMetricDataInput{
+		ServiceName: aws.String(req.Name),
+		MetricName:  types.ContainerServiceMetricName(req.MetricName),
+		Period:      req.Period,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Statistics:  req.Statistics,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.MetricData, nil
+}
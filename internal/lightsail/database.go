@@ -2,18 +2,24 @@ package lightsail
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
-	"github.com/aws/smithy-go"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/helper/encryption"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/tftags"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/verify"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceDatabase() *schema.Resource {
@@ -39,28 +45,31 @@ func ResourceDatabase() *schema.Resource {
 			},
 			"master_database_name": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"master_password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"manage_master_password"},
 			},
 			"master_username": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"blueprint_id": {
 				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Optional: true,
+				Computed: true,
 			},
 			"bundle_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The bundle of the database. Changing it recreates the database from a snapshot, which gives it a new ARN, endpoint and creation time, and loses the alarms and parameters set outside of this resource.",
 			},
 			// Optional attributes
 			"preferred_backup_window": {
@@ -97,6 +106,75 @@ func ResourceDatabase() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{DatabaseStateAvailable, DatabaseStateStopped}, false),
+			},
+			"reboot_trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// managed master password attributes
+			"manage_master_password": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"master_password"},
+			},
+			"rotate_password_trigger": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"manage_master_password"},
+			},
+			"pgp_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"manage_master_password"},
+			},
+			"generated_master_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"encrypted_master_password": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// restore attributes
+			"source_snapshot_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_database_name"},
+			},
+			"source_database_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_snapshot_name"},
+			},
+			"restore_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				RequiredWith:  []string{"source_database_name"},
+				ConflictsWith: []string{"use_latest_restorable_time"},
+			},
+			"use_latest_restorable_time": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				RequiredWith:  []string{"source_database_name"},
+				ConflictsWith: []string{"restore_time"},
+			},
 			// additional info returned from the API
 			"arn": {
 				Type:     schema.TypeString,
@@ -146,10 +224,15 @@ func ResourceDatabase() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags":     TagsSchema(),
-			"tags_all": TagsSchemaComputed(),
+			"monitoring": monitoringSchema(types.ResourceTypeRelationalDatabase),
+			"tags":       TagsSchema(),
+			"tags_all":   TagsSchemaComputed(),
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			resourceDatabaseBundleDiff,
+			resourceDatabaseImpactDiff,
+		),
 	}
 }
 
@@ -158,58 +241,78 @@ func resourceDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	req := lightsail.CreateRelationalDatabaseInput{
-		MasterDatabaseName:            aws.String(d.Get("master_database_name").(string)),
-		MasterUsername:                aws.String(d.Get("master_username").(string)),
-		RelationalDatabaseBlueprintId: aws.String(d.Get("blueprint_id").(string)),
-		RelationalDatabaseBundleId:    aws.String(d.Get("bundle_id").(string)),
-		RelationalDatabaseName:        aws.String(d.Get("name").(string)),
-	}
+	_, restoreFromSnapshot := d.GetOk("source_snapshot_name")
+	_, restoreFromDatabase := d.GetOk("source_database_name")
 
-	if v, ok := d.GetOk("availability_zone"); ok {
-		req.AvailabilityZone = aws.String(v.(string))
-	}
+	if restoreFromSnapshot || restoreFromDatabase {
+		if err := resourceDatabaseCreateFromSnapshot(d, conn, tags); err != nil {
+			return err
+		}
+	} else {
+		for _, k := range []string{"master_database_name", "master_username", "blueprint_id"} {
+			if _, ok := d.GetOk(k); !ok {
+				return fmt.Errorf("Lightsail Database %s is required when source_snapshot_name or source_database_name is not set", k)
+			}
+		}
 
-	if v, ok := d.GetOk("master_password"); ok {
-		req.MasterUserPassword = aws.String(v.(string))
-	}
+		if _, ok := d.GetOk("master_password"); !ok && !d.Get("manage_master_password").(bool) {
+			return fmt.Errorf("Lightsail Database master_password is required when manage_master_password is not set")
+		}
 
-	if v, ok := d.GetOk("preferred_backup_window"); ok {
-		req.PreferredBackupWindow = aws.String(v.(string))
-	}
+		req := lightsail.CreateRelationalDatabaseInput{
+			MasterDatabaseName:            aws.String(d.Get("master_database_name").(string)),
+			MasterUsername:                aws.String(d.Get("master_username").(string)),
+			RelationalDatabaseBlueprintId: aws.String(d.Get("blueprint_id").(string)),
+			RelationalDatabaseBundleId:    aws.String(d.Get("bundle_id").(string)),
+			RelationalDatabaseName:        aws.String(d.Get("name").(string)),
+		}
 
-	if v, ok := d.GetOk("preferred_maintenance_window"); ok {
-		req.PreferredMaintenanceWindow = aws.String(v.(string))
-	}
+		if v, ok := d.GetOk("availability_zone"); ok {
+			req.AvailabilityZone = aws.String(v.(string))
+		}
 
-	if v, ok := d.GetOk("publicly_accessible"); ok {
-		req.PubliclyAccessible = aws.Bool(v.(bool))
-	}
+		if v, ok := d.GetOk("master_password"); ok {
+			req.MasterUserPassword = aws.String(v.(string))
+		}
 
-	if len(tags) > 0 {
-		req.Tags = Tags(tags.IgnoreAWS())
-	}
+		if v, ok := d.GetOk("preferred_backup_window"); ok {
+			req.PreferredBackupWindow = aws.String(v.(string))
+		}
 
-	resp, err := conn.CreateRelationalDatabase(context.TODO(), &req)
-	if err != nil {
-		return err
-	}
+		if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+			req.PreferredMaintenanceWindow = aws.String(v.(string))
+		}
 
-	if len(resp.Operations) == 0 {
-		return fmt.Errorf("No operations found for Create Relational Database request")
-	}
+		if v, ok := d.GetOk("publicly_accessible"); ok {
+			req.PubliclyAccessible = aws.Bool(v.(bool))
+		}
 
-	op := resp.Operations[0]
-	d.SetId(d.Get("name").(string))
+		if len(tags) > 0 {
+			req.Tags = Tags(tags.IgnoreAWS())
+		}
 
-	err = waitLightsailOperation(conn, op.Id)
-	if err != nil {
-		return fmt.Errorf("Error waiting for Relational Database (%s) to become ready: %s", d.Id(), err)
+		resp, err := conn.CreateRelationalDatabase(context.TODO(), &req)
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Operations) == 0 {
+			return fmt.Errorf("No operations found for Create Relational Database request")
+		}
+
+		op := resp.Operations[0]
+		d.SetId(d.Get("name").(string))
+
+		err = waitLightsailOperation(conn, op.Id)
+		if err != nil {
+			return fmt.Errorf("Error waiting for Relational Database (%s) to become ready: %s", d.Id(), err)
+		}
 	}
 
 	// Backup Retention is not a value you can pass on creation and defaults to true.
 	// Forcing an update of the value after creation if the backup_retention_enabled value is false.
-	if v := d.Get("backup_retention_enabled"); v == false {
+	// Restored databases have the value updated while restoring.
+	if v := d.Get("backup_retention_enabled"); v == false && !restoreFromSnapshot && !restoreFromDatabase {
 		log.Printf("[DEBUG] Lightsail Database (%s) backup_retention_enabled setting is false. Updating value.", d.Id())
 		req := lightsail.UpdateRelationalDatabaseInput{
 			ApplyImmediately:       aws.Bool(true),
@@ -241,14 +344,153 @@ func resourceDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Some Operations can complete before the Database enters the Available state. Added a waiter to make sure the Database is available before continuing.
-	err = waitDatabaseModified(conn, aws.String(d.Id()))
+	err := waitDatabaseModified(conn, aws.String(d.Id()))
 	if err != nil {
 		return fmt.Errorf("Error waiting for Relational Database (%s) to become available: %s", d.Id(), err)
 	}
 
+	if d.Get("manage_master_password").(bool) {
+		if err := setDatabaseGeneratedMasterPassword(d, conn); err != nil {
+			return err
+		}
+	}
+
+	if err := putMonitoringAlarms(conn, d.Id(), types.ResourceTypeRelationalDatabase, d.Get("monitoring").([]interface{}), false); err != nil {
+		return err
+	}
+
+	if d.Get("state").(string) == DatabaseStateStopped {
+		if err := stopDatabase(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
 	return resourceDatabaseRead(d, meta)
 }
 
+func resourceDatabaseCreateFromSnapshot(d *schema.ResourceData, conn *lightsail.Client, tags tftags.KeyValueTags) error {
+	req := lightsail.CreateRelationalDatabaseFromSnapshotInput{
+		RelationalDatabaseBundleId: aws.String(d.Get("bundle_id").(string)),
+		RelationalDatabaseName:     aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		req.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("publicly_accessible"); ok {
+		req.PubliclyAccessible = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("source_snapshot_name"); ok {
+		req.RelationalDatabaseSnapshotName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_database_name"); ok {
+		req.SourceRelationalDatabaseName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("restore_time"); ok {
+		// The value has already been validated as RFC3339
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		req.RestoreTime = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("use_latest_restorable_time"); ok {
+		req.UseLatestRestorableTime = aws.Bool(v.(bool))
+	}
+
+	if len(tags) > 0 {
+		req.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	resp, err := conn.CreateRelationalDatabaseFromSnapshot(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for Create Relational Database From Snapshot request")
+	}
+
+	op := resp.Operations[0]
+	d.SetId(d.Get("name").(string))
+
+	err = waitLightsailOperation(conn, op.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return updateDatabaseAfterRestore(d, conn)
+}
+
+// updateDatabaseAfterRestore applies the settings which can not be passed when restoring a database from a snapshot.
+func updateDatabaseAfterRestore(d *schema.ResourceData, conn *lightsail.Client) error {
+	// The master password, preferred windows and backup retention are not values you can pass on restore.
+	// Forcing an update of the values after restoring if any of them are configured.
+	req := lightsail.UpdateRelationalDatabaseInput{
+		ApplyImmediately:       aws.Bool(true),
+		RelationalDatabaseName: aws.String(d.Id()),
+	}
+	requestUpdate := false
+
+	if v, ok := d.GetOk("master_password"); ok {
+		req.MasterUserPassword = aws.String(v.(string))
+		requestUpdate = true
+	}
+
+	if v, ok := d.GetOk("preferred_backup_window"); ok {
+		req.PreferredBackupWindow = aws.String(v.(string))
+		requestUpdate = true
+	}
+
+	if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+		req.PreferredMaintenanceWindow = aws.String(v.(string))
+		requestUpdate = true
+	}
+
+	disableBackupRetention := !d.Get("backup_retention_enabled").(bool)
+	if disableBackupRetention {
+		req.DisableBackupRetention = aws.Bool(true)
+		requestUpdate = true
+	}
+
+	if !requestUpdate {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Lightsail Database (%s) restored from snapshot. Updating master password, preferred windows and backup retention.", d.Id())
+
+	// The restored database has to be available before it can be modified.
+	err := waitDatabaseModified(conn, aws.String(d.Id()))
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become available: %s", d.Id(), err)
+	}
+
+	resp, err := conn.UpdateRelationalDatabase(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for Update Relational Database request")
+	}
+
+	err = waitLightsailOperation(conn, resp.Operations[0].Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become ready: %s", d.Id(), err)
+	}
+
+	if disableBackupRetention {
+		err = waitDatabaseBackupRetentionModified(conn, aws.String(d.Id()), aws.Bool(false))
+		if err != nil {
+			return fmt.Errorf("Error waiting for Relational Database (%s) Backup Retention to be updated: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
 func resourceDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LightsailConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -257,28 +499,30 @@ func resourceDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 	// Some Operations can complete before the Database enters the Available state. Added a waiter to make sure the Database is available before continuing.
 	// This is to support importing a resource that is not in a ready state.
 	err := waitDatabaseModified(conn, aws.String(d.Id()))
-	if err != nil {
-		var oe *smithy.OperationError
-		if errors.As(err, &oe) {
-			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
-		}
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Database (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Database (%s): %w", d.Id(), err)
+	}
+
 	resp, err := conn.GetRelationalDatabase(context.TODO(), &lightsail.GetRelationalDatabaseInput{
 		RelationalDatabaseName: aws.String(d.Id()),
 	})
 
-	if err != nil {
-		var oe *smithy.OperationError
-		if errors.As(err, &oe) {
-			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
-		}
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Database (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Database (%s): %w", d.Id(), err)
+	}
+
 	if resp == nil {
 		log.Printf("[WARN] Lightsail Relational Database (%s) not found, nil response from server, removing from state", d.Id())
 		d.SetId("")
@@ -315,6 +559,20 @@ func resourceDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("secondary_availability_zone", rd.SecondaryAvailabilityZone)
 	d.Set("support_code", rd.SupportCode)
 
+	monitoring, err := readMonitoringAlarms(conn, d.Id(), types.ResourceTypeRelationalDatabase, d.Get("monitoring").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("monitoring", monitoring); err != nil {
+		return fmt.Errorf("error setting monitoring: %w", err)
+	}
+
+	// Only track the states which can be configured, transitional states are ignored.
+	if state := aws.ToString(rd.State); state == DatabaseStateAvailable || state == DatabaseStateStopped {
+		d.Set("state", state)
+	}
+
 	tags := KeyValueTags(rd.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -338,6 +596,10 @@ func resourceDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error waiting for Relational Database (%s) to become available: %s", d.Id(), err)
 	}
 
+	if err := deleteMonitoringAlarms(conn, d.Id(), types.ResourceTypeRelationalDatabase, d.Get("monitoring").([]interface{})); err != nil {
+		return err
+	}
+
 	skipFinalSnapshot := d.Get("skip_final_snapshot").(bool)
 
 	req := lightsail.DeleteRelationalDatabaseInput{
@@ -370,46 +632,459 @@ func resourceDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceDatabaseImport(
-	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	// Neither skip_final_snapshot nor final_snapshot_identifier can be fetched
-	// from any API call, so we need to default skip_final_snapshot to true so
-	// that final_snapshot_identifier is not required
-	d.Set("skip_final_snapshot", true)
-	return []*schema.ResourceData{d}, nil
-}
+// resizeDatabaseBundle moves the database to a new bundle. Lightsail does not support changing the bundle of
+// an existing database, so the database is stopped to block writes, a snapshot is taken and the database is
+// restored from it with the new bundle. The snapshot is kept when the restore fails.
+func resizeDatabaseBundle(d *schema.ResourceData, conn *lightsail.Client) error {
+	o, n := d.GetChange("bundle_id")
+	suffix := time.Now().UTC().Format("20060102150405")
+	snapshotName := fmt.Sprintf("%s-resize-%s", d.Id(), suffix)
 
-func resourceDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LightsailConn
-	requestUpdate := false
+	log.Printf("[DEBUG] Resizing Lightsail Database (%s) from bundle %s to %s using snapshot (%s)", d.Id(), o, n, snapshotName)
 
-	req := lightsail.UpdateRelationalDatabaseInput{
-		ApplyImmediately:       aws.Bool(d.Get("apply_immediately").(bool)),
+	err := waitDatabaseModified(conn, aws.String(d.Id()))
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become available: %s", d.Id(), err)
+	}
+
+	resp, err := conn.GetRelationalDatabase(context.TODO(), &lightsail.GetRelationalDatabaseInput{
 		RelationalDatabaseName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Database (%s): %w", d.Id(), err)
 	}
 
-	if d.HasChange("ca_certificate_identifier") {
-		req.CaCertificateIdentifier = aws.String(d.Get("ca_certificate_identifier").(string))
-		requestUpdate = true
+	// Stopping the database blocks writes, so no write is lost between the snapshot and the delete.
+	wasAvailable := aws.ToString(resp.RelationalDatabase.State) == DatabaseStateAvailable
+	if wasAvailable {
+		if err := stopDatabase(conn, d.Id()); err != nil {
+			return err
+		}
 	}
 
-	if d.HasChange("backup_retention_enabled") {
-		if d.Get("backup_retention_enabled").(bool) {
-			req.EnableBackupRetention = aws.Bool(d.Get("backup_retention_enabled").(bool))
-		} else {
-			req.DisableBackupRetention = aws.Bool(true)
+	// abort leaves the original database as it was and keeps the snapshot, if it has been created
+	abort := func(err error) error {
+		if wasAvailable {
+			if startErr := startDatabase(conn, d.Id()); startErr != nil {
+				log.Printf("[WARN] error starting Lightsail Database (%s) after failed resize: %s", d.Id(), startErr)
+			}
 		}
-		requestUpdate = true
+
+		return err
 	}
 
-	if d.HasChange("master_password") {
-		req.MasterUserPassword = aws.String(d.Get("master_password").(string))
-		requestUpdate = true
+	snapshotResp, err := conn.CreateRelationalDatabaseSnapshot(context.TODO(), &lightsail.CreateRelationalDatabaseSnapshotInput{
+		RelationalDatabaseName:         aws.String(d.Id()),
+		RelationalDatabaseSnapshotName: aws.String(snapshotName),
+	})
+
+	if err != nil {
+		return abort(fmt.Errorf("error creating Lightsail Database (%s) resize snapshot: %w", d.Id(), err))
 	}
 
-	if d.HasChange("preferred_backup_window") {
-		req.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
-		requestUpdate = true
+	if len(snapshotResp.Operations) == 0 {
+		return abort(fmt.Errorf("No operations found for CreateRelationalDatabaseSnapshot request"))
+	}
+
+	err = waitLightsailOperation(conn, snapshotResp.Operations[0].Id)
+	if err != nil {
+		return abort(fmt.Errorf("Error waiting for Database Snapshot (%s) to become ready: %s", snapshotName, err))
+	}
+
+	err = waitDatabaseModified(conn, aws.String(d.Id()))
+	if err != nil {
+		return abort(fmt.Errorf("Error waiting for Relational Database (%s) to become available: %s", d.Id(), err))
+	}
+
+	// The snapshot holds every write, as the database has been stopped before it was taken.
+	if err := deleteDatabaseWithoutSnapshot(conn, d.Id()); err != nil {
+		return abort(fmt.Errorf("error deleting Lightsail Database (%s) for resize, the snapshot (%s) has been kept: %w", d.Id(), snapshotName, err))
+	}
+
+	req := &lightsail.CreateRelationalDatabaseFromSnapshotInput{
+		AvailabilityZone:               aws.String(d.Get("availability_zone").(string)),
+		PubliclyAccessible:             aws.Bool(d.Get("publicly_accessible").(bool)),
+		RelationalDatabaseBundleId:     aws.String(n.(string)),
+		RelationalDatabaseName:         aws.String(d.Id()),
+		RelationalDatabaseSnapshotName: aws.String(snapshotName),
+	}
+
+	if tags := tftags.New(d.Get("tags_all").(map[string]interface{})); len(tags) > 0 {
+		req.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if err := restoreDatabaseSnapshot(conn, req); err != nil {
+		return fmt.Errorf("error restoring Lightsail Database (%s) from resize snapshot (%s), the database has been deleted and the snapshot has been kept. Restore it by setting source_snapshot_name to %q: %w", d.Id(), snapshotName, snapshotName, err)
+	}
+
+	err = updateDatabaseAfterRestore(d, conn)
+	if err != nil {
+		return err
+	}
+
+	err = waitDatabaseModified(conn, aws.String(d.Id()))
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become available: %s", d.Id(), err)
+	}
+
+	// The snapshot is only needed until the database has been restored.
+	cleanupResp, err := conn.DeleteRelationalDatabaseSnapshot(context.TODO(), &lightsail.DeleteRelationalDatabaseSnapshotInput{
+		RelationalDatabaseSnapshotName: aws.String(snapshotName),
+	})
+
+	if err != nil {
+		log.Printf("[WARN] error deleting Lightsail Database (%s) resize snapshot (%s): %s", d.Id(), snapshotName, err)
+		return nil
+	}
+
+	if len(cleanupResp.Operations) > 0 {
+		err = waitLightsailOperation(conn, cleanupResp.Operations[0].Id)
+		if err != nil {
+			log.Printf("[WARN] error waiting for Lightsail Database (%s) resize snapshot (%s) to be deleted: %s", d.Id(), snapshotName, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreDatabaseSnapshot creates a database from a snapshot and waits for it to become available
+func restoreDatabaseSnapshot(conn *lightsail.Client, req *lightsail.CreateRelationalDatabaseFromSnapshotInput) error {
+	resp, err := conn.CreateRelationalDatabaseFromSnapshot(context.TODO(), req)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for Create Relational Database From Snapshot request")
+	}
+
+	err = waitLightsailOperation(conn, resp.Operations[0].Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become ready: %s", aws.ToString(req.RelationalDatabaseName), err)
+	}
+
+	err = waitDatabaseModified(conn, req.RelationalDatabaseName)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become available: %s", aws.ToString(req.RelationalDatabaseName), err)
+	}
+
+	return nil
+}
+
+// deleteDatabaseWithoutSnapshot deletes a database which has already been snapshotted
+func deleteDatabaseWithoutSnapshot(conn *lightsail.Client, name string) error {
+	resp, err := conn.DeleteRelationalDatabase(context.TODO(), &lightsail.DeleteRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(name),
+		SkipFinalSnapshot:      aws.Bool(true),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for DeleteRelationalDatabase request")
+	}
+
+	err = waitLightsailOperation(conn, resp.Operations[0].Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to Delete: %s", name, err)
+	}
+
+	return nil
+}
+
+// resourceDatabaseBundleDiff requires apply_immediately for bundle changes, as the resize can not be
+// deferred to the maintenance window and causes an outage.
+func resourceDatabaseBundleDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("bundle_id") {
+		return nil
+	}
+
+	if !diff.Get("apply_immediately").(bool) {
+		return fmt.Errorf("changing bundle_id of Lightsail Database (%s) requires apply_immediately to be true, as the database is restored from a snapshot with the new bundle", diff.Id())
+	}
+
+	// The restored database is a new database with its own ARN, endpoint and hardware.
+	for _, k := range []string{"arn", "created_at", "master_endpoint_address", "secondary_availability_zone", "cpu_count", "ram_size", "disk_size"} {
+		if err := diff.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceDatabaseImpactDiff validates bundle_id and blueprint_id against the bundles and blueprints offered
+// by Lightsail, and describes the impact of changes which cause downtime in the provider log. A blueprint
+// change which needs a new database is planned as a replacement.
+func resourceDatabaseImpactDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	if diff.HasChange("bundle_id") && diff.NewValueKnown("bundle_id") {
+		bundles, err := getDatabaseBundles(conn)
+		if err != nil {
+			return fmt.Errorf("error reading Lightsail Database bundles to validate bundle_id: %w", err)
+		}
+
+		o, n := diff.GetChange("bundle_id")
+		newBundle, ok := bundles[n.(string)]
+		if !ok {
+			return fmt.Errorf("bundle_id %q is not a valid Lightsail Database bundle", n)
+		}
+
+		if !aws.ToBool(newBundle.IsActive) {
+			return fmt.Errorf("bundle_id %q is not an active Lightsail Database bundle", n)
+		}
+
+		if oldBundle, ok := bundles[o.(string)]; ok && diff.Id() != "" {
+			if aws.ToInt32(newBundle.DiskSizeInGb) < aws.ToInt32(oldBundle.DiskSizeInGb) {
+				return fmt.Errorf("Lightsail Database (%s) can not change bundle_id from %q to %q, the disk of the new bundle (%d GB) is smaller than the current disk (%d GB)", diff.Id(), o, n, aws.ToInt32(newBundle.DiskSizeInGb), aws.ToInt32(oldBundle.DiskSizeInGb))
+			}
+
+			impact := fmt.Sprintf("bundle_id change from %q to %q requires downtime: the database is recreated from a snapshot with the new bundle, is unavailable until the restore completes, gets a new ARN and endpoint and loses the alarms and parameters set outside of this resource", o, n)
+			if isDatabaseHighAvailabilityBundle(oldBundle) != isDatabaseHighAvailabilityBundle(newBundle) {
+				impact = fmt.Sprintf("bundle_id change from %q to %q switches between a standard and a high availability bundle and requires downtime: the database is recreated from a snapshot with the new bundle, is unavailable until the restore completes, gets a new ARN and endpoint and loses the alarms and parameters set outside of this resource", o, n)
+			}
+
+			log.Printf("[WARN] Lightsail Database (%s) %s", diff.Id(), impact)
+		}
+	}
+
+	if diff.Id() != "" && diff.HasChange("blueprint_id") && !diff.NewValueKnown("blueprint_id") {
+		if err := diff.ForceNew("blueprint_id"); err != nil {
+			return err
+		}
+	}
+
+	if diff.HasChange("blueprint_id") && diff.NewValueKnown("blueprint_id") && diff.Get("blueprint_id").(string) != "" {
+		blueprints, err := getDatabaseBlueprints(conn)
+		if err != nil {
+			return fmt.Errorf("error reading Lightsail Database blueprints to validate blueprint_id: %w", err)
+		}
+
+		o, n := diff.GetChange("blueprint_id")
+		newBlueprint, ok := blueprints[n.(string)]
+		if !ok {
+			return fmt.Errorf("blueprint_id %q is not a valid Lightsail Database blueprint", n)
+		}
+
+		if diff.Id() != "" {
+			// Lightsail upgrades the engine in place when the new blueprint is a newer version of the same
+			// engine, any other change of blueprint needs a new database.
+			if oldBlueprint, ok := blueprints[o.(string)]; ok && isDatabaseEngineUpgrade(oldBlueprint, newBlueprint) {
+				if err := diff.SetNewComputed("engine_version"); err != nil {
+					return err
+				}
+
+				log.Printf("[WARN] Lightsail Database (%s) blueprint_id change from %q to %q upgrades the engine in place %s: the database is unavailable while it is upgraded", diff.Id(), o, n, databaseChangeApplied(diff))
+			} else {
+				// A replaced database is planned as a new resource, which shows the impact in the plan.
+				if err := diff.ForceNew("blueprint_id"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if diff.Id() != "" && diff.HasChange("publicly_accessible") {
+		verb := "refused"
+		if diff.Get("publicly_accessible").(bool) {
+			verb = "allowed"
+		}
+
+		log.Printf("[WARN] Lightsail Database (%s) publicly_accessible change to %t is applied %s: connections from outside of Lightsail are %s from then on", diff.Id(), diff.Get("publicly_accessible").(bool), databaseChangeApplied(diff), verb)
+	}
+
+	return nil
+}
+
+// databaseChangeApplied describes when a change of the database is applied
+func databaseChangeApplied(diff *schema.ResourceDiff) string {
+	if diff.Get("apply_immediately").(bool) {
+		return "immediately"
+	}
+
+	return "during the next maintenance window"
+}
+
+// getDatabaseBundles returns all database bundles offered by Lightsail keyed by bundle ID
+func getDatabaseBundles(conn *lightsail.Client) (map[string]types.RelationalDatabaseBundle, error) {
+	bundles := make(map[string]types.RelationalDatabaseBundle)
+	// Inactive bundles are included, so that they can be told apart from bundles which do not exist.
+	input := &lightsail.GetRelationalDatabaseBundlesInput{
+		IncludeInactive: aws.Bool(true),
+	}
+
+	for {
+		resp, err := conn.GetRelationalDatabaseBundles(context.TODO(), input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, b := range resp.Bundles {
+			bundles[aws.ToString(b.BundleId)] = b
+		}
+
+		if aws.ToString(resp.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = resp.NextPageToken
+	}
+
+	return bundles, nil
+}
+
+// getDatabaseBlueprints returns all database blueprints offered by Lightsail keyed by blueprint ID
+func getDatabaseBlueprints(conn *lightsail.Client) (map[string]types.RelationalDatabaseBlueprint, error) {
+	blueprints := make(map[string]types.RelationalDatabaseBlueprint)
+	input := &lightsail.GetRelationalDatabaseBlueprintsInput{}
+
+	for {
+		resp, err := conn.GetRelationalDatabaseBlueprints(context.TODO(), input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, b := range resp.Blueprints {
+			blueprints[aws.ToString(b.BlueprintId)] = b
+		}
+
+		if aws.ToString(resp.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = resp.NextPageToken
+	}
+
+	return blueprints, nil
+}
+
+// isDatabaseEngineUpgrade reports whether moving from one blueprint to the other is an upgrade of the
+// same engine, which Lightsail applies to the existing database
+func isDatabaseEngineUpgrade(o, n types.RelationalDatabaseBlueprint) bool {
+	if o.Engine != n.Engine {
+		return false
+	}
+
+	return compareDatabaseEngineVersions(aws.ToString(o.EngineVersion), aws.ToString(n.EngineVersion)) < 0
+}
+
+// compareDatabaseEngineVersions compares dot separated engine versions such as 5.7.44 and 8.0.35,
+// returning -1, 0 or 1 when a is lower than, equal to or higher than b
+func compareDatabaseEngineVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var av, bv int
+		if i < len(as) {
+			av, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bv, _ = strconv.Atoi(bs[i])
+		}
+
+		if av < bv {
+			return -1
+		}
+		if av > bv {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// databaseHighAvailabilityBundleRegexp matches the IDs of high availability bundles, e.g. micro_ha_2_0
+var databaseHighAvailabilityBundleRegexp = regexp.MustCompile(`^[a-z0-9]+_ha_[0-9]+_[0-9]+$`)
+
+// isDatabaseHighAvailabilityBundle reports whether a bundle returned by GetRelationalDatabaseBundles is a
+// high availability bundle. The API has no flag for it, the bundle ID is <size>_ha_<version> instead.
+func isDatabaseHighAvailabilityBundle(bundle types.RelationalDatabaseBundle) bool {
+	return databaseHighAvailabilityBundleRegexp.MatchString(aws.ToString(bundle.BundleId))
+}
+
+func resourceDatabaseImport(
+	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Neither skip_final_snapshot nor final_snapshot_identifier can be fetched
+	// from any API call, so we need to default skip_final_snapshot to true so
+	// that final_snapshot_identifier is not required
+	d.Set("skip_final_snapshot", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+// databaseRunningChanges are the arguments which can only be changed while the database is running
+var databaseRunningChanges = []string{
+	"backup_retention_enabled",
+	"blueprint_id",
+	"bundle_id",
+	"ca_certificate_identifier",
+	"manage_master_password",
+	"master_password",
+	"preferred_backup_window",
+	"preferred_maintenance_window",
+	"publicly_accessible",
+	"rotate_password_trigger",
+}
+
+func resourceDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	requestUpdate := false
+
+	// A stopped database has to be started before it can be modified. When it should stay stopped it is
+	// started for the changes and stopped again afterwards.
+	o, n := d.GetChange("state")
+	restartStopped := o.(string) == DatabaseStateStopped && n.(string) == DatabaseStateStopped && d.HasChanges(databaseRunningChanges...)
+
+	if (d.HasChange("state") && n.(string) == DatabaseStateAvailable) || restartStopped {
+		if err := startDatabase(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("bundle_id") {
+		if err := resizeDatabaseBundle(d, conn); err != nil {
+			return err
+		}
+	}
+
+	req := lightsail.UpdateRelationalDatabaseInput{
+		ApplyImmediately:       aws.Bool(d.Get("apply_immediately").(bool)),
+		RelationalDatabaseName: aws.String(d.Id()),
+	}
+
+	// Only engine upgrades reach Update, any other blueprint change replaces the database.
+	if d.HasChange("blueprint_id") {
+		req.RelationalDatabaseBlueprintId = aws.String(d.Get("blueprint_id").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("ca_certificate_identifier") {
+		req.CaCertificateIdentifier = aws.String(d.Get("ca_certificate_identifier").(string))
+		requestUpdate = true
+	}
+
+	if d.HasChange("backup_retention_enabled") {
+		if d.Get("backup_retention_enabled").(bool) {
+			req.EnableBackupRetention = aws.Bool(d.Get("backup_retention_enabled").(bool))
+		} else {
+			req.DisableBackupRetention = aws.Bool(true)
+		}
+		requestUpdate = true
+	}
+
+	// Switching to manage_master_password clears master_password, the password is then rotated below instead.
+	if v := d.Get("master_password").(string); d.HasChange("master_password") && v != "" && !d.Get("manage_master_password").(bool) {
+		req.MasterUserPassword = aws.String(v)
+		requestUpdate = true
+	}
+
+	if d.HasChange("preferred_backup_window") {
+		req.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
+		requestUpdate = true
 	}
 
 	if d.HasChange("preferred_maintenance_window") {
@@ -462,5 +1137,195 @@ func resourceDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.Get("manage_master_password").(bool) {
+		if d.HasChanges("manage_master_password", "rotate_password_trigger") {
+			if err := rotateDatabaseMasterPassword(d, conn); err != nil {
+				return err
+			}
+		} else if d.HasChange("pgp_key") {
+			if err := setDatabaseGeneratedMasterPassword(d, conn); err != nil {
+				return err
+			}
+		}
+	} else if d.HasChange("manage_master_password") {
+		d.Set("generated_master_password", nil)
+		d.Set("encrypted_master_password", nil)
+		d.Set("encrypted_fingerprint", nil)
+	}
+
+	// Resizing the bundle replaces the database, which removes its alarms.
+	if d.HasChanges("monitoring", "bundle_id") {
+		o, n := d.GetChange("monitoring")
+		if err := updateMonitoringAlarms(conn, d.Id(), types.ResourceTypeRelationalDatabase, o.([]interface{}), n.([]interface{})); err != nil {
+			// The alarms have not been changed, keep the monitoring block of the prior state
+			d.Set("monitoring", o)
+			return err
+		}
+	}
+
+	if d.HasChange("reboot_trigger") && d.Get("state").(string) != DatabaseStateStopped {
+		if err := rebootDatabase(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if (d.HasChange("state") && n.(string) == DatabaseStateStopped) || restartStopped {
+		if err := stopDatabase(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
 	return resourceDatabaseRead(d, meta)
 }
+
+func rotateDatabaseMasterPassword(d *schema.ResourceData, conn *lightsail.Client) error {
+	err := waitDatabaseModified(conn, aws.String(d.Id()))
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become available: %s", d.Id(), err)
+	}
+
+	resp, err := conn.UpdateRelationalDatabase(context.TODO(), &lightsail.UpdateRelationalDatabaseInput{
+		ApplyImmediately:         aws.Bool(true),
+		RelationalDatabaseName:   aws.String(d.Id()),
+		RotateMasterUserPassword: aws.Bool(true),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for Update Relational Database request")
+	}
+
+	err = waitLightsailOperation(conn, resp.Operations[0].Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) master password to rotate: %s", d.Id(), err)
+	}
+
+	err = waitDatabaseModified(conn, aws.String(d.Id()))
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become available: %s", d.Id(), err)
+	}
+
+	return setDatabaseGeneratedMasterPassword(d, conn)
+}
+
+// setDatabaseGeneratedMasterPassword stores the current master password generated by Lightsail. The
+// password is encrypted if a pgp_key is given, else it is stored in state.
+func setDatabaseGeneratedMasterPassword(d *schema.ResourceData, conn *lightsail.Client) error {
+	resp, err := conn.GetRelationalDatabaseMasterUserPassword(context.TODO(), &lightsail.GetRelationalDatabaseMasterUserPasswordInput{
+		RelationalDatabaseName: aws.String(d.Id()),
+		PasswordVersion:        types.RelationalDatabasePasswordVersionCurrent,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Database (%s) master password: %w", d.Id(), err)
+	}
+
+	pgpKey, err := encryption.RetrieveGPGKey(d.Get("pgp_key").(string))
+	if err != nil {
+		return err
+	}
+
+	if pgpKey != "" {
+		fingerprint, encrypted, err := encryption.EncryptValue(pgpKey, aws.ToString(resp.MasterUserPassword), "Lightsail Database Master Password")
+		if err != nil {
+			return err
+		}
+
+		d.Set("encrypted_fingerprint", fingerprint)
+		d.Set("encrypted_master_password", encrypted)
+		d.Set("generated_master_password", nil)
+	} else {
+		d.Set("generated_master_password", resp.MasterUserPassword)
+		d.Set("encrypted_fingerprint", nil)
+		d.Set("encrypted_master_password", nil)
+	}
+
+	return nil
+}
+
+func startDatabase(conn *lightsail.Client, name string) error {
+	resp, err := conn.StartRelationalDatabase(context.TODO(), &lightsail.StartRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(name),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for StartRelationalDatabase request")
+	}
+
+	err = waitLightsailOperation(conn, resp.Operations[0].Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to start: %s", name, err)
+	}
+
+	err = waitDatabaseStarted(conn, aws.String(name))
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become available: %s", name, err)
+	}
+
+	return nil
+}
+
+func stopDatabase(conn *lightsail.Client, name string) error {
+	resp, err := conn.StopRelationalDatabase(context.TODO(), &lightsail.StopRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(name),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for StopRelationalDatabase request")
+	}
+
+	err = waitLightsailOperation(conn, resp.Operations[0].Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to stop: %s", name, err)
+	}
+
+	err = waitDatabaseStopped(conn, aws.String(name))
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become stopped: %s", name, err)
+	}
+
+	return nil
+}
+
+func rebootDatabase(conn *lightsail.Client, name string) error {
+	// The database has to be available before it can be rebooted.
+	err := waitDatabaseModified(conn, aws.String(name))
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become available: %s", name, err)
+	}
+
+	resp, err := conn.RebootRelationalDatabase(context.TODO(), &lightsail.RebootRelationalDatabaseInput{
+		RelationalDatabaseName: aws.String(name),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for RebootRelationalDatabase request")
+	}
+
+	err = waitLightsailOperation(conn, resp.Operations[0].Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to reboot: %s", name, err)
+	}
+
+	err = waitDatabaseRebooted(conn, aws.String(name))
+	if err != nil {
+		return fmt.Errorf("Error waiting for Relational Database (%s) to become available: %s", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDatabaseLogEvents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDatabaseLogEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"log_stream_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"from_head": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"page_token": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_pages": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"log_events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"next_backward_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_forward_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDatabaseLogEventsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	dbName := d.Get("database_name").(string)
+	streamName := d.Get("log_stream_name").(string)
+	fromHead := d.Get("from_head").(bool)
+
+	input := &lightsail.GetRelationalDatabaseLogEventsInput{
+		RelationalDatabaseName: aws.String(dbName),
+		LogStreamName:          aws.String(streamName),
+		StartFromHead:          aws.Bool(fromHead),
+	}
+
+	// The values have already been validated as RFC3339
+	if v, ok := d.GetOk("start_time"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		input.StartTime = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("end_time"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		input.EndTime = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("page_token"); ok {
+		input.PageToken = aws.String(v.(string))
+	}
+
+	var events []interface{}
+	var nextBackwardToken, nextForwardToken *string
+
+	for page := 0; page < d.Get("max_pages").(int); page++ {
+		resp, err := conn.GetRelationalDatabaseLogEvents(context.TODO(), input)
+		if err != nil {
+			return fmt.Errorf("Error fetching Database (%s) Log Events for stream (%s): %w", dbName, streamName, err)
+		}
+
+		for _, e := range resp.ResourceLogEvents {
+			events = append(events, map[string]interface{}{
+				"created_at": e.CreatedAt.Format(time.RFC3339),
+				"message":    aws.ToString(e.Message),
+			})
+		}
+
+		nextBackwardToken = resp.NextBackwardToken
+		nextForwardToken = resp.NextForwardToken
+
+		// Reading from the head pages forward in time, otherwise backward. The end of the stream
+		// is reached when no events are returned or the same token is returned again.
+		next := resp.NextBackwardToken
+		if fromHead {
+			next = resp.NextForwardToken
+		}
+
+		if len(resp.ResourceLogEvents) == 0 || aws.ToString(next) == "" || aws.ToString(next) == aws.ToString(input.PageToken) {
+			break
+		}
+
+		input.PageToken = next
+	}
+
+	d.SetId(strings.Join([]string{dbName, streamName}, ","))
+
+	if err := d.Set("log_events", events); err != nil {
+		return fmt.Errorf("error setting log_events: %w", err)
+	}
+
+	d.Set("next_backward_token", nextBackwardToken)
+	d.Set("next_forward_token", nextForwardToken)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatabaseLogEventsDataSource_basic(t *testing.T) {
+	dsName := "data.awslightsail_database_log_events.test"
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseLogEventsDataSourceConfig(lName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "id", fmt.Sprintf("%s,error", lName)),
+					resource.TestCheckResourceAttrSet(dsName, "log_events.#"),
+					resource.TestCheckResourceAttrSet(dsName, "next_forward_token"),
+					resource.TestCheckResourceAttrSet(dsName, "next_backward_token"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseLogEventsDataSourceConfig(lName string) string {
+	return testAccDatabaseConfigBasic(lName) + `
+data "awslightsail_database_log_events" "test" {
+  database_name   = awslightsail_database.test.id
+  log_stream_name = "error"
+  from_head       = true
+  max_pages       = 5
+}
+`
+}
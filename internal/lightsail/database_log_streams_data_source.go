@@ -0,0 +1,50 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDatabaseLogStreams() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDatabaseLogStreamsRead,
+
+		Schema: map[string]*schema.Schema{
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"log_streams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDatabaseLogStreamsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	dbName := d.Get("database_name").(string)
+
+	resp, err := conn.GetRelationalDatabaseLogStreams(context.TODO(), &lightsail.GetRelationalDatabaseLogStreamsInput{
+		RelationalDatabaseName: aws.String(dbName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error fetching Database (%s) Log Streams: %w", dbName, err)
+	}
+
+	d.SetId(dbName)
+
+	if err := d.Set("log_streams", resp.LogStreams); err != nil {
+		return fmt.Errorf("error setting log_streams: %w", err)
+	}
+
+	return nil
+}
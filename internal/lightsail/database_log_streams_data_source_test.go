@@ -0,0 +1,38 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatabaseLogStreamsDataSource_basic(t *testing.T) {
+	dsName := "data.awslightsail_database_log_streams.test"
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseLogStreamsDataSourceConfig(lName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "id", lName),
+					resource.TestCheckResourceAttrSet(dsName, "log_streams.#"),
+					resource.TestCheckTypeSetElemAttr(dsName, "log_streams.*", "error"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseLogStreamsDataSourceConfig(lName string) string {
+	return testAccDatabaseConfigBasic(lName) + `
+data "awslightsail_database_log_streams" "test" {
+  database_name = awslightsail_database.test.id
+}
+`
+}
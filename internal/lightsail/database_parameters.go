@@ -0,0 +1,269 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// DatabaseParameterApplyMethodImmediate applies the parameter change immediately
+	DatabaseParameterApplyMethodImmediate = "immediate"
+	// DatabaseParameterApplyMethodPendingReboot applies the parameter change after the next reboot
+	DatabaseParameterApplyMethodPendingReboot = "pending-reboot"
+)
+
+func ResourceDatabaseParameters() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatabaseParametersPut,
+		Read:   resourceDatabaseParametersRead,
+		Update: resourceDatabaseParametersPut,
+		Delete: resourceDatabaseParametersDelete,
+		// No importer, the API does not return the default value of a parameter, so the parameters changed
+		// from their defaults can not be told apart from the others.
+
+		Schema: map[string]*schema.Schema{
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"apply_method": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  DatabaseParameterApplyMethodImmediate,
+							ValidateFunc: validation.StringInSlice([]string{
+								DatabaseParameterApplyMethodImmediate,
+								DatabaseParameterApplyMethodPendingReboot,
+							}, false),
+						},
+					},
+				},
+			},
+			"reboot_on_pending": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+		CustomizeDiff: resourceDatabaseParametersDiff,
+	}
+}
+
+// resourceDatabaseParametersDiff validates the parameter names against the parameters of the database engine,
+// so a typo is reported when planning. Databases created in the same apply are validated on apply.
+func resourceDatabaseParametersDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("database_name") || !diff.NewValueKnown("parameter") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).LightsailConn
+	dbName := diff.Get("database_name").(string)
+
+	current, err := getDatabaseParameters(conn, dbName)
+
+	if errs.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Database (%s) parameters: %w", dbName, err)
+	}
+
+	return validateDatabaseParameters(current, expandDatabaseParameters(diff.Get("parameter").(*schema.Set).List()), dbName)
+}
+
+func resourceDatabaseParametersPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	dbName := d.Get("database_name").(string)
+
+	current, err := getDatabaseParameters(conn, dbName)
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Database (%s) parameters: %w", dbName, err)
+	}
+
+	var parameters []types.RelationalDatabaseParameter
+
+	if d.IsNewResource() {
+		parameters = expandDatabaseParameters(d.Get("parameter").(*schema.Set).List())
+	} else {
+		o, n := d.GetChange("parameter")
+		parameters = expandDatabaseParameters(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+	}
+
+	// The parameters are validated when planning, unless the database did not exist yet.
+	if err := validateDatabaseParameters(current, parameters, dbName); err != nil {
+		return err
+	}
+
+	pendingReboot := false
+
+	for _, p := range parameters {
+		if aws.ToString(p.ApplyMethod) == DatabaseParameterApplyMethodPendingReboot {
+			pendingReboot = true
+		}
+	}
+
+	if len(parameters) > 0 {
+		resp, err := conn.UpdateRelationalDatabaseParameters(context.TODO(), &lightsail.UpdateRelationalDatabaseParametersInput{
+			RelationalDatabaseName: aws.String(dbName),
+			Parameters:             parameters,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Operations) == 0 {
+			return fmt.Errorf("No operations found for UpdateRelationalDatabaseParameters request")
+		}
+
+		op := resp.Operations[0]
+
+		err = waitLightsailOperation(conn, op.Id)
+		if err != nil {
+			return fmt.Errorf("Error waiting for Relational Database (%s) parameters to be updated: %s", dbName, err)
+		}
+	}
+
+	d.SetId(dbName)
+
+	if pendingReboot && d.Get("reboot_on_pending").(bool) {
+		log.Printf("[DEBUG] Lightsail Database (%s) has pending-reboot parameters. Rebooting.", dbName)
+
+		if err := rebootDatabase(conn, dbName); err != nil {
+			return err
+		}
+	}
+
+	return resourceDatabaseParametersRead(d, meta)
+}
+
+func resourceDatabaseParametersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	current, err := getDatabaseParameters(conn, d.Id())
+
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Database Parameters (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Database Parameters (%s): %w", d.Id(), err)
+	}
+
+	d.Set("database_name", d.Id())
+
+	// The API returns every parameter of the engine, only track the configured ones.
+	var parameters []interface{}
+
+	for _, raw := range d.Get("parameter").(*schema.Set).List() {
+		m := raw.(map[string]interface{})
+		p, ok := current[m["name"].(string)]
+		if !ok {
+			continue
+		}
+
+		parameters = append(parameters, map[string]interface{}{
+			"name":         aws.ToString(p.ParameterName),
+			"value":        aws.ToString(p.ParameterValue),
+			"apply_method": m["apply_method"].(string),
+		})
+	}
+
+	if err := d.Set("parameter", parameters); err != nil {
+		return fmt.Errorf("error setting parameter: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDatabaseParametersDelete(d *schema.ResourceData, meta interface{}) error {
+	// Lightsail does not offer a way to reset parameters to the engine defaults.
+	log.Printf("[WARN] Lightsail Database (%s) parameters can not be reset, removing from state only", d.Id())
+
+	return nil
+}
+
+// getDatabaseParameters returns all parameters of the database keyed by name
+func getDatabaseParameters(conn *lightsail.Client, dbName string) (map[string]types.RelationalDatabaseParameter, error) {
+	parameters := make(map[string]types.RelationalDatabaseParameter)
+
+	input := &lightsail.GetRelationalDatabaseParametersInput{
+		RelationalDatabaseName: aws.String(dbName),
+	}
+
+	for {
+		resp, err := conn.GetRelationalDatabaseParameters(context.TODO(), input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range resp.Parameters {
+			parameters[aws.ToString(p.ParameterName)] = p
+		}
+
+		if aws.ToString(resp.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = resp.NextPageToken
+	}
+
+	return parameters, nil
+}
+
+// validateDatabaseParameters checks the parameters exist for the engine of the database and can be modified
+func validateDatabaseParameters(current map[string]types.RelationalDatabaseParameter, parameters []types.RelationalDatabaseParameter, dbName string) error {
+	for _, p := range parameters {
+		c, ok := current[aws.ToString(p.ParameterName)]
+		if !ok {
+			return fmt.Errorf("parameter (%s) is not a valid parameter for Lightsail Database (%s)", aws.ToString(p.ParameterName), dbName)
+		}
+
+		if !aws.ToBool(c.IsModifiable) {
+			return fmt.Errorf("parameter (%s) can not be modified on Lightsail Database (%s)", aws.ToString(p.ParameterName), dbName)
+		}
+	}
+
+	return nil
+}
+
+func expandDatabaseParameters(configured []interface{}) []types.RelationalDatabaseParameter {
+	parameters := make([]types.RelationalDatabaseParameter, 0, len(configured))
+
+	for _, raw := range configured {
+		m := raw.(map[string]interface{})
+
+		parameters = append(parameters, types.RelationalDatabaseParameter{
+			ParameterName:  aws.String(m["name"].(string)),
+			ParameterValue: aws.String(m["value"].(string)),
+			ApplyMethod:    aws.String(m["apply_method"].(string)),
+		})
+	}
+
+	return parameters
+}
@@ -0,0 +1,158 @@
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDatabaseParameters_basic(t *testing.T) {
+	rName := "awslightsail_database_parameters.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseParametersConfigBasic(lName, "150"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseParameterValue(rName, "max_connections", "150"),
+					resource.TestCheckResourceAttr(rName, "database_name", lName),
+					resource.TestCheckResourceAttr(rName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "parameter.*", map[string]string{
+						"name":         "max_connections",
+						"value":        "150",
+						"apply_method": "pending-reboot",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "parameter.*", map[string]string{
+						"name":         "slow_query_log",
+						"value":        "1",
+						"apply_method": "immediate",
+					}),
+				),
+			},
+			{
+				Config: testAccDatabaseParametersConfigBasic(lName, "200"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseParameterValue(rName, "max_connections", "200"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "parameter.*", map[string]string{
+						"name":  "max_connections",
+						"value": "200",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseParameters_InvalidName(t *testing.T) {
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The database is created in the same apply, the name is validated on apply
+				Config:      testAccDatabaseParametersConfigInvalidName(lName),
+				ExpectError: regexp.MustCompile(`is not a valid parameter`),
+			},
+			{
+				// The database exists now, the name is validated when planning
+				Config:      testAccDatabaseParametersConfigInvalidName(lName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`is not a valid parameter`),
+			},
+		},
+	})
+}
+
+func testAccCheckDatabaseParameterValue(n, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Database Parameters ID is set")
+		}
+
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+		input := &lightsail.GetRelationalDatabaseParametersInput{
+			RelationalDatabaseName: aws.String(rs.Primary.ID),
+		}
+
+		for {
+			resp, err := conn.GetRelationalDatabaseParameters(context.TODO(), input)
+			if err != nil {
+				return err
+			}
+
+			for _, p := range resp.Parameters {
+				if aws.ToString(p.ParameterName) != name {
+					continue
+				}
+
+				if aws.ToString(p.ParameterValue) != value {
+					return fmt.Errorf("Database (%s) parameter %s is %q, expected %q", rs.Primary.ID, name, aws.ToString(p.ParameterValue), value)
+				}
+
+				return nil
+			}
+
+			if aws.ToString(resp.NextPageToken) == "" {
+				break
+			}
+
+			input.PageToken = resp.NextPageToken
+		}
+
+		return fmt.Errorf("Database (%s) parameter %s not found", rs.Primary.ID, name)
+	}
+}
+
+func testAccDatabaseParametersConfigBasic(lName, maxConnections string) string {
+	return testAccDatabaseConfigBasic(lName) + fmt.Sprintf(`
+resource "awslightsail_database_parameters" "test" {
+  database_name     = awslightsail_database.test.id
+  reboot_on_pending = true
+
+  parameter {
+    name         = "max_connections"
+    value        = %[1]q
+    apply_method = "pending-reboot"
+  }
+
+  parameter {
+    name  = "slow_query_log"
+    value = "1"
+  }
+}
+`, maxConnections)
+}
+
+func testAccDatabaseParametersConfigInvalidName(lName string) string {
+	return testAccDatabaseConfigBasic(lName) + `
+resource "awslightsail_database_parameters" "test" {
+  database_name = awslightsail_database.test.id
+
+  parameter {
+    name  = "not_a_real_parameter"
+    value = "1"
+  }
+}
+`
+}
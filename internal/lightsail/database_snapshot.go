@@ -0,0 +1,217 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/tftags"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/verify"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDatabaseSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatabaseSnapshotCreate,
+		Read:   resourceDatabaseSnapshotRead,
+		Update: resourceDatabaseSnapshotUpdate,
+		Delete: resourceDatabaseSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z]`), "must begin with an alphabetic character"),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_\-.]+[^._\-]$`), "must contain only alphanumeric characters, underscores, hyphens, and dots"),
+				),
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     TagsSchema(),
+			"tags_all": TagsSchemaComputed(),
+			// additional info returned from the API
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"from_database_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"from_blueprint_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"from_bundle_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size_in_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDatabaseSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	req := lightsail.CreateRelationalDatabaseSnapshotInput{
+		RelationalDatabaseName:         aws.String(d.Get("database_name").(string)),
+		RelationalDatabaseSnapshotName: aws.String(d.Get("name").(string)),
+	}
+
+	if len(tags) > 0 {
+		req.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	resp, err := conn.CreateRelationalDatabaseSnapshot(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for CreateRelationalDatabaseSnapshot request")
+	}
+
+	op := resp.Operations[0]
+	d.SetId(d.Get("name").(string))
+
+	err = waitLightsailOperation(conn, op.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Database Snapshot (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceDatabaseSnapshotRead(d, meta)
+}
+
+func resourceDatabaseSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	resp, err := conn.GetRelationalDatabaseSnapshot(context.TODO(), &lightsail.GetRelationalDatabaseSnapshotInput{
+		RelationalDatabaseSnapshotName: aws.String(d.Id()),
+	})
+
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Database Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Database Snapshot (%s): %w", d.Id(), err)
+	}
+
+	if resp == nil || resp.RelationalDatabaseSnapshot == nil {
+		log.Printf("[WARN] Lightsail Database Snapshot (%s) not found, nil response from server, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	s := resp.RelationalDatabaseSnapshot
+
+	d.Set("name", s.Name)
+	d.Set("database_name", s.FromRelationalDatabaseName)
+
+	// additional attributes
+	d.Set("arn", s.Arn)
+	d.Set("created_at", s.CreatedAt.Format(time.RFC3339))
+	d.Set("engine", s.Engine)
+	d.Set("engine_version", s.EngineVersion)
+	d.Set("from_database_arn", s.FromRelationalDatabaseArn)
+	d.Set("from_blueprint_id", s.FromRelationalDatabaseBlueprintId)
+	d.Set("from_bundle_id", s.FromRelationalDatabaseBundleId)
+	d.Set("size_in_gb", s.SizeInGb)
+	d.Set("state", s.State)
+	d.Set("support_code", s.SupportCode)
+
+	tags := KeyValueTags(s.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDatabaseSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Lightsail Database Snapshot (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDatabaseSnapshotRead(d, meta)
+}
+
+func resourceDatabaseSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	resp, err := conn.DeleteRelationalDatabaseSnapshot(context.TODO(), &lightsail.DeleteRelationalDatabaseSnapshotInput{
+		RelationalDatabaseSnapshotName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	op := resp.Operations[0]
+
+	err = waitLightsailOperation(conn, op.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Database Snapshot (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/smithy-go"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDatabaseSnapshot_basic(t *testing.T) {
+	rName := "awslightsail_database_snapshot.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDatabaseSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSnapshotConfigBasic(lName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseSnapshotExists(rName),
+					resource.TestCheckResourceAttr(rName, "name", fmt.Sprintf("%s-snapshot", lName)),
+					resource.TestCheckResourceAttr(rName, "database_name", lName),
+					resource.TestCheckResourceAttr(rName, "from_blueprint_id", "mysql_8_0"),
+					resource.TestCheckResourceAttr(rName, "from_bundle_id", "micro_1_0"),
+					resource.TestCheckResourceAttrSet(rName, "arn"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+					resource.TestCheckResourceAttrSet(rName, "engine"),
+					resource.TestCheckResourceAttrSet(rName, "engine_version"),
+					resource.TestCheckResourceAttrSet(rName, "size_in_gb"),
+					resource.TestCheckResourceAttrSet(rName, "support_code"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDatabaseSnapshot_Tags(t *testing.T) {
+	rName := "awslightsail_database_snapshot.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDatabaseSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSnapshotConfigTags1(lName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseSnapshotExists(rName),
+					resource.TestCheckResourceAttr(rName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(rName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatabaseSnapshotConfigTags1(lName, "key1", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseSnapshotExists(rName),
+					resource.TestCheckResourceAttr(rName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(rName, "tags.key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatabaseSnapshotExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Database Snapshot ID is set")
+		}
+
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+		resp, err := conn.GetRelationalDatabaseSnapshot(context.TODO(), &lightsail.GetRelationalDatabaseSnapshotInput{
+			RelationalDatabaseSnapshotName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.RelationalDatabaseSnapshot == nil {
+			return fmt.Errorf("Database Snapshot (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDatabaseSnapshotDestroy(s *terraform.State) error {
+	conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "awslightsail_database_snapshot" {
+			continue
+		}
+
+		_, err := conn.GetRelationalDatabaseSnapshot(context.TODO(), &lightsail.GetRelationalDatabaseSnapshotInput{
+			RelationalDatabaseSnapshotName: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			return fmt.Errorf("Lightsail Database Snapshot %q still exists", rs.Primary.ID)
+		}
+
+		// Verify the error
+		var oe *smithy.OperationError
+		if errors.As(err, &oe) {
+			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
+		}
+	}
+
+	return testAccCheckAWSDatabaseDestroy(s)
+}
+
+func testAccDatabaseSnapshotConfigBase(lName string) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_database" "test" {
+  name                 = %[1]q
+  availability_zone    = data.awslightsail_availability_zones.all.database_names[0]
+  master_database_name = "testdatabasename"
+  master_password      = "testdatabasepassword"
+  master_username      = "test"
+  blueprint_id         = "mysql_8_0"
+  bundle_id            = "micro_1_0"
+  skip_final_snapshot  = true
+}
+`, lName)
+}
+
+func testAccDatabaseSnapshotConfigBasic(lName string) string {
+	return testAccDatabaseSnapshotConfigBase(lName) + fmt.Sprintf(`
+resource "awslightsail_database_snapshot" "test" {
+  name          = "%[1]s-snapshot"
+  database_name = awslightsail_database.test.id
+}
+`, lName)
+}
+
+func testAccDatabaseSnapshotConfigTags1(lName, tagKey1, tagValue1 string) string {
+	return testAccDatabaseSnapshotConfigBase(lName) + fmt.Sprintf(`
+resource "awslightsail_database_snapshot" "test" {
+  name          = "%[1]s-snapshot"
+  database_name = awslightsail_database.test.id
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, lName, tagKey1, tagValue1)
+}
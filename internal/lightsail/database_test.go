@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 	"time"
 
@@ -311,6 +312,29 @@ func TestAccDatabase_BackupRetentionEnabled(t *testing.T) {
 					resource.TestCheckResourceAttr(rName, "backup_retention_enabled", "false"),
 				),
 			},
+			{
+				Config:      testAccDatabaseConfigBundleId(lName, "micro_1_0"),
+				ExpectError: regexp.MustCompile(`is smaller than the current disk`),
+			},
+		},
+	})
+}
+
+func TestAccDatabase_InvalidBundleAndBlueprint(t *testing.T) {
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDatabaseConfigBundleId(lName, "not_a_bundle_1_0"),
+				ExpectError: regexp.MustCompile(`is not a valid Lightsail Database bundle`),
+			},
+			{
+				Config:      testAccDatabaseConfigBlueprintId(lName, "not_a_blueprint"),
+				ExpectError: regexp.MustCompile(`is not a valid Lightsail Database blueprint`),
+			},
 		},
 	})
 }
@@ -393,6 +417,296 @@ func TestAccDatabase_Tags(t *testing.T) {
 	})
 }
 
+func TestAccDatabase_BundleId(t *testing.T) {
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	rName := "awslightsail_database.test"
+	var arn string
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfigBundleId(lName, "micro_1_0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "bundle_id", "micro_1_0"),
+					testAccCheckDatabaseARN(rName, &arn, false),
+				),
+			},
+			{
+				Config: testAccDatabaseConfigBundleId(lName, "small_1_0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "bundle_id", "small_1_0"),
+					testAccCheckDatabaseARN(rName, &arn, true),
+					resource.TestCheckResourceAttr(rName, "master_database_name", "testdatabasename"),
+					resource.TestCheckResourceAttr(rName, "preferred_backup_window", "09:30-10:00"),
+					resource.TestCheckResourceAttr(rName, "backup_retention_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabase_BlueprintUpgrade(t *testing.T) {
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	rName := "awslightsail_database.test"
+	var arn string
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfigBlueprintId(lName, "mysql_5_7"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "blueprint_id", "mysql_5_7"),
+					testAccCheckDatabaseARN(rName, &arn, false),
+				),
+			},
+			{
+				Config: testAccDatabaseConfigBlueprintId(lName, "mysql_8_0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "blueprint_id", "mysql_8_0"),
+					resource.TestMatchResourceAttr(rName, "engine_version", regexp.MustCompile(`^8\.0\.`)),
+					testAccCheckDatabaseARN(rName, &arn, false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabase_State(t *testing.T) {
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	rName := "awslightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfigState(lName, "available"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "state", "available"),
+				),
+			},
+			{
+				Config: testAccDatabaseConfigState(lName, "stopped"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "state", "stopped"),
+				),
+			},
+			{
+				Config: testAccDatabaseConfigStateBackupWindow(lName, "stopped", "09:30-10:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "state", "stopped"),
+					resource.TestCheckResourceAttr(rName, "preferred_backup_window", "09:30-10:00"),
+				),
+			},
+			{
+				Config: testAccDatabaseConfigState(lName, "available"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "state", "available"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabase_RebootTrigger(t *testing.T) {
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	rName := "awslightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfigRebootTrigger(lName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "reboot_trigger.rev", "1"),
+				),
+			},
+			{
+				Config: testAccDatabaseConfigRebootTrigger(lName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "reboot_trigger.rev", "2"),
+					resource.TestCheckResourceAttr(rName, "state", "available"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabase_Monitoring(t *testing.T) {
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	rName := "awslightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfigMonitoring(lName, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "monitoring.#", "1"),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.cpu_utilization_threshold", "80"),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.free_storage_space_threshold", "2"),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.evaluation_periods", "2"),
+					resource.TestCheckTypeSetElemAttr(rName, "monitoring.0.contact_protocols.*", "Email"),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.alarm_names.#", "2"),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.alarm_names.0", fmt.Sprintf("%s-cpu-utilization", lName)),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.alarm_names.1", fmt.Sprintf("%s-free-storage-space", lName)),
+				),
+			},
+			{
+				Config: testAccDatabaseConfigMonitoring(lName, 95),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.cpu_utilization_threshold", "95"),
+					testAccCheckDatabaseDeleteAlarm(fmt.Sprintf("%s-free-storage-space", lName)),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDatabaseConfigMonitoring(lName, 95),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.alarm_names.#", "2"),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.alarm_names.1", fmt.Sprintf("%s-free-storage-space", lName)),
+				),
+			},
+			{
+				Config: testAccDatabaseConfigBasic(lName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "monitoring.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabase_ManageMasterPassword(t *testing.T) {
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	rName := "awslightsail_database.test"
+	var password string
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfigManageMasterPassword(lName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "manage_master_password", "true"),
+					resource.TestCheckResourceAttrSet(rName, "generated_master_password"),
+					resource.TestCheckNoResourceAttr(rName, "encrypted_master_password"),
+					testAccCheckDatabaseGeneratedMasterPassword(rName, &password, false),
+				),
+			},
+			{
+				Config: testAccDatabaseConfigManageMasterPassword(lName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					testAccCheckDatabaseGeneratedMasterPassword(rName, &password, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabase_ManageMasterPasswordSwitch(t *testing.T) {
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	rName := "awslightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfigBasic(lName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckNoResourceAttr(rName, "generated_master_password"),
+				),
+			},
+			{
+				Config: testAccDatabaseConfigManageMasterPassword(lName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "manage_master_password", "true"),
+					resource.TestCheckResourceAttrSet(rName, "generated_master_password"),
+				),
+			},
+			{
+				Config: testAccDatabaseConfigBasic(lName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "master_password", "testdatabasepassword"),
+					resource.TestCheckNoResourceAttr(rName, "generated_master_password"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabase_ManageMasterPasswordEncrypted(t *testing.T) {
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	rName := "awslightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckAWSDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfigManageMasterPasswordEncrypted(lName, testKeyPairPubKey1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttrSet(rName, "encrypted_master_password"),
+					resource.TestCheckResourceAttrSet(rName, "encrypted_fingerprint"),
+					resource.TestCheckNoResourceAttr(rName, "generated_master_password"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabase_SourceSnapshotName(t *testing.T) {
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	rName := "awslightsail_database.restore"
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDatabaseSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfigSourceSnapshotName(lName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSDatabaseExists(rName),
+					resource.TestCheckResourceAttr(rName, "name", fmt.Sprintf("%s-restore", lName)),
+					resource.TestCheckResourceAttr(rName, "blueprint_id", "mysql_8_0"),
+					resource.TestCheckResourceAttr(rName, "bundle_id", "small_1_0"),
+					resource.TestCheckResourceAttr(rName, "master_database_name", "testdatabasename"),
+					resource.TestCheckResourceAttr(rName, "master_username", "test"),
+					resource.TestCheckResourceAttr(rName, "preferred_backup_window", "09:30-10:00"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDatabase_disappears(t *testing.T) {
 	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
 	rName := "awslightsail_database.test"
@@ -462,6 +776,69 @@ func testAccCheckAWSDatabaseExists(n string) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckDatabaseGeneratedMasterPassword stores the generated master password, checking that it
+// differs from the previously stored password when rotated is true.
+func testAccCheckDatabaseGeneratedMasterPassword(n string, password *string, rotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		value := rs.Primary.Attributes["generated_master_password"]
+
+		if rotated && value == *password {
+			return fmt.Errorf("Database (%s) master password was not rotated", rs.Primary.ID)
+		}
+
+		*password = value
+
+		return nil
+	}
+}
+
+// testAccCheckDatabaseDeleteAlarm deletes a monitoring alarm outside of Terraform
+func testAccCheckDatabaseDeleteAlarm(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+		_, err := conn.DeleteAlarm(context.TODO(), &lightsail.DeleteAlarmInput{
+			AlarmName: aws.String(name),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error deleting Lightsail Alarm (%s): %s", name, err)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDatabaseARN stores the ARN of the database, checking that it differs from the previously
+// stored ARN when replaced is true and that it is unchanged otherwise.
+func testAccCheckDatabaseARN(n string, arn *string, replaced bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		value := rs.Primary.Attributes["arn"]
+
+		if *arn != "" && replaced && value == *arn {
+			return fmt.Errorf("Database (%s) ARN did not change", rs.Primary.ID)
+		}
+
+		if *arn != "" && !replaced && value != *arn {
+			return fmt.Errorf("Database (%s) ARN changed from %s to %s", rs.Primary.ID, *arn, value)
+		}
+
+		*arn = value
+
+		return nil
+	}
+}
+
 func testAccCheckAWSDatabaseDestroy(s *terraform.State) error {
 	conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
 
@@ -684,6 +1061,180 @@ resource "awslightsail_database" "test" {
 `, lName, sName)
 }
 
+func testAccDatabaseConfigBundleId(lName string, bundleId string) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_database" "test" {
+  name                     = %[1]q
+  availability_zone        = data.awslightsail_availability_zones.all.database_names[0]
+  master_database_name     = "testdatabasename"
+  master_password          = "testdatabasepassword"
+  master_username          = "test"
+  blueprint_id             = "mysql_8_0"
+  bundle_id                = %[2]q
+  preferred_backup_window  = "09:30-10:00"
+  backup_retention_enabled = false
+  apply_immediately        = true
+  skip_final_snapshot      = true
+}
+`, lName, bundleId)
+}
+
+func testAccDatabaseConfigBlueprintId(lName string, blueprintId string) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_database" "test" {
+  name                 = %[1]q
+  availability_zone    = data.awslightsail_availability_zones.all.database_names[0]
+  master_database_name = "testdatabasename"
+  master_password      = "testdatabasepassword"
+  master_username      = "test"
+  blueprint_id         = %[2]q
+  bundle_id            = "micro_1_0"
+  apply_immediately    = true
+  skip_final_snapshot  = true
+}
+`, lName, blueprintId)
+}
+
+func testAccDatabaseConfigState(lName string, state string) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_database" "test" {
+  name                 = %[1]q
+  availability_zone    = data.awslightsail_availability_zones.all.database_names[0]
+  master_database_name = "testdatabasename"
+  master_password      = "testdatabasepassword"
+  master_username      = "test"
+  blueprint_id         = "mysql_8_0"
+  bundle_id            = "micro_1_0"
+  state                = %[2]q
+  skip_final_snapshot  = true
+}
+`, lName, state)
+}
+
+func testAccDatabaseConfigStateBackupWindow(lName string, state string, preferredBackupWindow string) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_database" "test" {
+  name                    = %[1]q
+  availability_zone       = data.awslightsail_availability_zones.all.database_names[0]
+  master_database_name    = "testdatabasename"
+  master_password         = "testdatabasepassword"
+  master_username         = "test"
+  blueprint_id            = "mysql_8_0"
+  bundle_id               = "micro_1_0"
+  state                   = %[2]q
+  preferred_backup_window = %[3]q
+  apply_immediately       = true
+  skip_final_snapshot     = true
+}
+`, lName, state, preferredBackupWindow)
+}
+
+func testAccDatabaseConfigRebootTrigger(lName string, rev string) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_database" "test" {
+  name                 = %[1]q
+  availability_zone    = data.awslightsail_availability_zones.all.database_names[0]
+  master_database_name = "testdatabasename"
+  master_password      = "testdatabasepassword"
+  master_username      = "test"
+  blueprint_id         = "mysql_8_0"
+  bundle_id            = "micro_1_0"
+  skip_final_snapshot  = true
+
+  reboot_trigger = {
+    rev = %[2]q
+  }
+}
+`, lName, rev)
+}
+
+func testAccDatabaseConfigMonitoring(lName string, cpuThreshold int) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_database" "test" {
+  name                 = %[1]q
+  availability_zone    = data.awslightsail_availability_zones.all.database_names[0]
+  master_database_name = "testdatabasename"
+  master_password      = "testdatabasepassword"
+  master_username      = "test"
+  blueprint_id         = "mysql_8_0"
+  bundle_id            = "micro_1_0"
+  skip_final_snapshot  = true
+
+  monitoring {
+    contact_protocols         = ["Email"]
+    cpu_utilization_threshold = %[2]d
+  }
+}
+`, lName, cpuThreshold)
+}
+
+func testAccDatabaseConfigManageMasterPassword(lName string, rotation string) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_database" "test" {
+  name                   = %[1]q
+  availability_zone      = data.awslightsail_availability_zones.all.database_names[0]
+  master_database_name   = "testdatabasename"
+  master_username        = "test"
+  manage_master_password = true
+  blueprint_id           = "mysql_8_0"
+  bundle_id              = "micro_1_0"
+  skip_final_snapshot    = true
+
+  rotate_password_trigger = {
+    rotation = %[2]q
+  }
+}
+`, lName, rotation)
+}
+
+func testAccDatabaseConfigManageMasterPasswordEncrypted(lName string, key string) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_database" "test" {
+  name                   = %[1]q
+  availability_zone      = data.awslightsail_availability_zones.all.database_names[0]
+  master_database_name   = "testdatabasename"
+  master_username        = "test"
+  manage_master_password = true
+  blueprint_id           = "mysql_8_0"
+  bundle_id              = "micro_1_0"
+  skip_final_snapshot    = true
+  pgp_key                = <<EOF
+%[2]s
+EOF
+}
+`, lName, key)
+}
+
+func testAccDatabaseConfigSourceSnapshotName(lName string) string {
+	return testAccDatabaseSnapshotConfigBasic(lName) + fmt.Sprintf(`
+resource "awslightsail_database" "restore" {
+  name                    = "%[1]s-restore"
+  availability_zone       = data.awslightsail_availability_zones.all.database_names[0]
+  source_snapshot_name    = awslightsail_database_snapshot.test.id
+  master_password         = "restoredpassword"
+  preferred_backup_window = "09:30-10:00"
+  bundle_id               = "small_1_0"
+  skip_final_snapshot     = true
+}
+`, lName)
+}
+
 func testAccDatabaseConfigTags1(lName string, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`	
 data "awslightsail_availability_zones" "all" {}
@@ -2,15 +2,14 @@ package lightsail
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
-	"github.com/aws/smithy-go"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,6 +18,9 @@ func ResourceDiskAttachment() *schema.Resource {
 		Create: resourceDiskAttachmentCreate,
 		Read:   resourceDiskAttachmentRead,
 		Delete: resourceDiskAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"disk_name": {
@@ -66,7 +68,7 @@ func resourceDiskAttachmentCreate(d *schema.ResourceData, meta interface{}) erro
 		d.Get("instance_name").(string),
 	}
 
-	d.SetId(strings.Join(vars, "_"))
+	d.SetId(strings.Join(vars, ","))
 
 	return resourceDiskAttachmentRead(d, meta)
 }
@@ -74,27 +76,25 @@ func resourceDiskAttachmentCreate(d *schema.ResourceData, meta interface{}) erro
 func resourceDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LightsailConn
 
-	id_parts := strings.SplitN(d.Id(), "_", -1)
-	if len(id_parts) != 2 {
-		return nil
+	dname, iname, err := expandDiskAttachmentId(d.Id())
+	if err != nil {
+		return err
 	}
 
-	dname := id_parts[0]
-	iname := id_parts[1]
-
 	resp, err := conn.GetDisk(context.TODO(), &lightsail.GetDiskInput{
 		DiskName: aws.String(dname),
 	})
 
-	if err != nil {
-		var oe *smithy.OperationError
-		if errors.As(err, &oe) {
-			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
-		}
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Disk Attachment (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Disk Attachment (%s): %w", d.Id(), err)
+	}
+
 	disk := resp.Disk
 
 	if !*disk.IsAttached {
@@ -119,14 +119,11 @@ func resourceDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error
 func resourceDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LightsailConn
 
-	id_parts := strings.SplitN(d.Id(), "_", -1)
-	if len(id_parts) != 2 {
-		return nil
+	dname, iname, err := expandDiskAttachmentId(d.Id())
+	if err != nil {
+		return err
 	}
 
-	dname := id_parts[0]
-	iname := id_parts[1]
-
 	// You must first Stop an instance in order to detach a disk.
 	stopresp, stoperr := conn.StopInstance(context.TODO(), &lightsail.StopInstanceInput{
 		InstanceName: aws.String(iname),
@@ -175,3 +172,18 @@ func resourceDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+// expandDiskAttachmentId returns the disk name and instance name of the attachment. IDs created by earlier
+// versions of the provider are joined with an underscore.
+func expandDiskAttachmentId(id string) (string, string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 2 {
+		idParts = strings.Split(id, "_")
+	}
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected DISK_NAME,INSTANCE_NAME", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -33,6 +33,11 @@ func TestAccDiskAttachment_basic(t *testing.T) {
 					testAccCheckDiskAttachmentExists(rName),
 				),
 			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -108,7 +113,7 @@ func testAccCheckDiskAttachmentExists(n string) resource.TestCheckFunc {
 
 		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
 
-		id_parts := strings.SplitN(rs.Primary.ID, "_", -1)
+		id_parts := strings.Split(rs.Primary.ID, ",")
 
 		if len(id_parts) != 2 {
 			return nil
@@ -146,7 +151,7 @@ func testAccCheckDiskAttachmentDestroy(s *terraform.State) error {
 
 		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
 
-		id_parts := strings.SplitN(rs.Primary.ID, "_", -1)
+		id_parts := strings.Split(rs.Primary.ID, ",")
 		if len(id_parts) != 2 {
 			return nil
 		}
@@ -0,0 +1,890 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/tftags"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/verify"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDistribution() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDistributionCreate,
+		Read:   resourceDistributionRead,
+		Update: resourceDistributionUpdate,
+		Delete: resourceDistributionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z]`), "must begin with an alphabetic character"),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_\-.]+[^._\-]$`), "must contain only alphanumeric characters, underscores, hyphens, and dots"),
+				),
+			},
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"origin": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"region_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"protocol_policy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"http-only",
+								"https-only",
+							}, false),
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"default_cache_behavior": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"behavior": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"cache",
+								"dont-cache",
+							}, false),
+						},
+					},
+				},
+			},
+			"cache_behavior_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_http_methods": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"cached_http_methods": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"default_ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"maximum_ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"minimum_ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"forwarded_cookies": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"option": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"none",
+											"allow-list",
+											"all",
+										}, false),
+									},
+									"cookies_allow_list": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+									},
+								},
+							},
+						},
+						"forwarded_headers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"option": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"none",
+											"allow-list",
+											"all",
+										}, false),
+									},
+									"headers_allow_list": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"Accept",
+												"Accept-Charset",
+												"Accept-Datetime",
+												"Accept-Encoding",
+												"Accept-Language",
+												"Authorization",
+												"CloudFront-Forwarded-Proto",
+												"CloudFront-Is-Desktop-Viewer",
+												"CloudFront-Is-Mobile-Viewer",
+												"CloudFront-Is-SmartTV-Viewer",
+												"CloudFront-Is-Tablet-Viewer",
+												"CloudFront-Viewer-Country",
+												"Host",
+												"Origin",
+												"Referer",
+											}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+						"forwarded_query_strings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"option": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"query_strings_allowed_list": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"cache_behavior": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"behavior": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"cache",
+								"dont-cache",
+							}, false),
+						},
+					},
+				},
+			},
+			"certificate_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"is_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"ip_address_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "dualstack",
+				ValidateFunc: validation.StringInSlice([]string{
+					"dualstack",
+					"ipv4",
+				}, false),
+			},
+			// additional info returned from the API
+			"able_to_update_bundle": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"alternative_domain_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"origin_public_dns": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     TagsSchema(),
+			"tags_all": TagsSchemaComputed(),
+		},
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			resourceDistributionBundleDiff,
+		),
+	}
+}
+
+func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	req := lightsail.CreateDistributionInput{
+		DistributionName:     aws.String(d.Get("name").(string)),
+		BundleId:             aws.String(d.Get("bundle_id").(string)),
+		Origin:               expandDistributionOrigin(d.Get("origin").([]interface{})),
+		DefaultCacheBehavior: expandDistributionCacheBehavior(d.Get("default_cache_behavior").([]interface{})),
+		IpAddressType:        types.IpAddressType(d.Get("ip_address_type").(string)),
+	}
+
+	if v, ok := d.GetOk("cache_behavior_settings"); ok {
+		req.CacheBehaviorSettings = expandDistributionCacheSettings(v.([]interface{}), distributionConfiguredTTLs(d))
+	}
+
+	if v, ok := d.GetOk("cache_behavior"); ok {
+		req.CacheBehaviors = expandDistributionCacheBehaviors(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		req.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	resp, err := conn.CreateDistribution(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+
+	if resp.Operation == nil {
+		return fmt.Errorf("No operation found for CreateDistribution request")
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	err = waitLightsailOperation(conn, resp.Operation.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Distribution (%s) to become ready: %s", d.Id(), err)
+	}
+
+	// The certificate and enabled state can not be passed on creation.
+	if v, ok := d.GetOk("certificate_name"); ok {
+		if err := attachDistributionCertificate(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	if !d.Get("is_enabled").(bool) {
+		if err := updateDistribution(conn, &lightsail.UpdateDistributionInput{
+			DistributionName: aws.String(d.Id()),
+			IsEnabled:        aws.Bool(false),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return resourceDistributionRead(d, meta)
+}
+
+func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	resp, err := conn.GetDistributions(context.TODO(), &lightsail.GetDistributionsInput{
+		DistributionName: aws.String(d.Id()),
+	})
+
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Distribution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Distribution (%s): %w", d.Id(), err)
+	}
+
+	if len(resp.Distributions) == 0 {
+		log.Printf("[WARN] Lightsail Distribution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	dist := resp.Distributions[0]
+
+	d.Set("name", dist.Name)
+	d.Set("bundle_id", dist.BundleId)
+	d.Set("certificate_name", dist.CertificateName)
+	d.Set("is_enabled", dist.IsEnabled)
+	d.Set("ip_address_type", dist.IpAddressType)
+
+	if err := d.Set("origin", flattenDistributionOrigin(dist.Origin)); err != nil {
+		return fmt.Errorf("error setting origin: %w", err)
+	}
+
+	if err := d.Set("default_cache_behavior", flattenDistributionCacheBehavior(dist.DefaultCacheBehavior)); err != nil {
+		return fmt.Errorf("error setting default_cache_behavior: %w", err)
+	}
+
+	if err := d.Set("cache_behavior_settings", flattenDistributionCacheSettings(dist.CacheBehaviorSettings)); err != nil {
+		return fmt.Errorf("error setting cache_behavior_settings: %w", err)
+	}
+
+	if err := d.Set("cache_behavior", flattenDistributionCacheBehaviors(dist.CacheBehaviors)); err != nil {
+		return fmt.Errorf("error setting cache_behavior: %w", err)
+	}
+
+	// additional attributes
+	d.Set("able_to_update_bundle", dist.AbleToUpdateBundle)
+	d.Set("alternative_domain_names", dist.AlternativeDomainNames)
+	d.Set("arn", dist.Arn)
+	d.Set("created_at", dist.CreatedAt.Format(time.RFC3339))
+	d.Set("domain_name", dist.DomainName)
+	d.Set("origin_public_dns", dist.OriginPublicDNS)
+	d.Set("status", dist.Status)
+	d.Set("support_code", dist.SupportCode)
+
+	tags := KeyValueTags(dist.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Lightsail Distribution (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	req := lightsail.UpdateDistributionInput{
+		DistributionName: aws.String(d.Id()),
+	}
+	requestUpdate := false
+
+	if d.HasChange("origin") {
+		req.Origin = expandDistributionOrigin(d.Get("origin").([]interface{}))
+		requestUpdate = true
+	}
+
+	if d.HasChange("default_cache_behavior") {
+		req.DefaultCacheBehavior = expandDistributionCacheBehavior(d.Get("default_cache_behavior").([]interface{}))
+		requestUpdate = true
+	}
+
+	if d.HasChange("cache_behavior_settings") {
+		req.CacheBehaviorSettings = expandDistributionCacheSettings(d.Get("cache_behavior_settings").([]interface{}), distributionConfiguredTTLs(d))
+		requestUpdate = true
+	}
+
+	if d.HasChange("cache_behavior") {
+		req.CacheBehaviors = expandDistributionCacheBehaviors(d.Get("cache_behavior").(*schema.Set))
+		requestUpdate = true
+	}
+
+	if d.HasChange("is_enabled") {
+		req.IsEnabled = aws.Bool(d.Get("is_enabled").(bool))
+		requestUpdate = true
+	}
+
+	if requestUpdate {
+		if err := updateDistribution(conn, &req); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("bundle_id") {
+		resp, err := conn.UpdateDistributionBundle(context.TODO(), &lightsail.UpdateDistributionBundleInput{
+			DistributionName: aws.String(d.Id()),
+			BundleId:         aws.String(d.Get("bundle_id").(string)),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if resp.Operation == nil {
+			return fmt.Errorf("No operation found for UpdateDistributionBundle request")
+		}
+
+		err = waitLightsailOperation(conn, resp.Operation.Id)
+		if err != nil {
+			return fmt.Errorf("Error waiting for Distribution (%s) to become ready: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("certificate_name") {
+		o, n := d.GetChange("certificate_name")
+
+		if o.(string) != "" {
+			if err := detachDistributionCertificate(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+
+		if n.(string) != "" {
+			if err := attachDistributionCertificate(conn, d.Id(), n.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("ip_address_type") {
+		resp, err := conn.SetIpAddressType(context.TODO(), &lightsail.SetIpAddressTypeInput{
+			ResourceType:  types.ResourceTypeDistribution,
+			IpAddressType: types.IpAddressType(d.Get("ip_address_type").(string)),
+			ResourceName:  aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Operations) == 0 {
+			return fmt.Errorf("No operations found for SetIpAddressType request")
+		}
+
+		op := resp.Operations[0]
+
+		err = waitLightsailOperation(conn, op.Id)
+		if err != nil {
+			return fmt.Errorf("Error waiting for Distribution (%s) to become ready: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDistributionRead(d, meta)
+}
+
+func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	resp, err := conn.DeleteDistribution(context.TODO(), &lightsail.DeleteDistributionInput{
+		DistributionName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if resp.Operation == nil {
+		return fmt.Errorf("No operation found for DeleteDistribution request")
+	}
+
+	err = waitLightsailOperation(conn, resp.Operation.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Distribution (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceDistributionBundleDiff returns an error at plan time when the bundle of
+// an existing distribution is changed while Lightsail does not allow it. A
+// distribution's bundle can only be changed once per billing cycle.
+func resourceDistributionBundleDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("bundle_id") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	resp, err := conn.GetDistributions(context.TODO(), &lightsail.GetDistributionsInput{
+		DistributionName: aws.String(diff.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Distribution (%s) to validate bundle_id change: %w", diff.Id(), err)
+	}
+
+	if len(resp.Distributions) == 0 {
+		return nil
+	}
+
+	if !aws.ToBool(resp.Distributions[0].AbleToUpdateBundle) {
+		o, n := diff.GetChange("bundle_id")
+		return fmt.Errorf("Lightsail Distribution (%s) can not currently change bundle_id from %q to %q. A distribution's bundle can only be changed once per billing cycle", diff.Id(), o, n)
+	}
+
+	return nil
+}
+
+func updateDistribution(conn *lightsail.Client, req *lightsail.UpdateDistributionInput) error {
+	distributionName := aws.ToString(req.DistributionName)
+
+	resp, err := conn.UpdateDistribution(context.TODO(), req)
+	if err != nil {
+		return err
+	}
+
+	if resp.Operation == nil {
+		return fmt.Errorf("No operation found for UpdateDistribution request")
+	}
+
+	err = waitLightsailOperation(conn, resp.Operation.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Distribution (%s) to become ready: %s", distributionName, err)
+	}
+
+	return nil
+}
+
+func attachDistributionCertificate(conn *lightsail.Client, distributionName string, certificateName string) error {
+	resp, err := conn.AttachCertificateToDistribution(context.TODO(), &lightsail.AttachCertificateToDistributionInput{
+		DistributionName: aws.String(distributionName),
+		CertificateName:  aws.String(certificateName),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if resp.Operation == nil {
+		return fmt.Errorf("No operation found for AttachCertificateToDistribution request")
+	}
+
+	err = waitLightsailOperation(conn, resp.Operation.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Certificate (%s) to attach to Distribution (%s): %s", certificateName, distributionName, err)
+	}
+
+	return nil
+}
+
+func detachDistributionCertificate(conn *lightsail.Client, distributionName string) error {
+	resp, err := conn.DetachCertificateFromDistribution(context.TODO(), &lightsail.DetachCertificateFromDistributionInput{
+		DistributionName: aws.String(distributionName),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if resp.Operation == nil {
+		return fmt.Errorf("No operation found for DetachCertificateFromDistribution request")
+	}
+
+	err = waitLightsailOperation(conn, resp.Operation.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Certificate to detach from Distribution (%s): %s", distributionName, err)
+	}
+
+	return nil
+}
+
+func expandDistributionOrigin(rawOrigin []interface{}) *types.InputOrigin {
+	if len(rawOrigin) == 0 || rawOrigin[0] == nil {
+		return nil
+	}
+
+	m := rawOrigin[0].(map[string]interface{})
+
+	origin := &types.InputOrigin{
+		Name:       aws.String(m["name"].(string)),
+		RegionName: types.RegionName(m["region_name"].(string)),
+	}
+
+	if v, ok := m["protocol_policy"].(string); ok && v != "" {
+		origin.ProtocolPolicy = types.OriginProtocolPolicyEnum(v)
+	}
+
+	return origin
+}
+
+func flattenDistributionOrigin(origin *types.Origin) []interface{} {
+	if origin == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"name":            aws.ToString(origin.Name),
+		"region_name":     string(origin.RegionName),
+		"protocol_policy": string(origin.ProtocolPolicy),
+		"resource_type":   string(origin.ResourceType),
+	}
+
+	return []interface{}{m}
+}
+
+func expandDistributionCacheBehavior(rawCacheBehavior []interface{}) *types.CacheBehavior {
+	if len(rawCacheBehavior) == 0 || rawCacheBehavior[0] == nil {
+		return nil
+	}
+
+	m := rawCacheBehavior[0].(map[string]interface{})
+
+	return &types.CacheBehavior{
+		Behavior: types.BehaviorEnum(m["behavior"].(string)),
+	}
+}
+
+func flattenDistributionCacheBehavior(cacheBehavior *types.CacheBehavior) []interface{} {
+	if cacheBehavior == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"behavior": string(cacheBehavior.Behavior),
+	}
+
+	return []interface{}{m}
+}
+
+func expandDistributionCacheBehaviors(rawCacheBehaviors *schema.Set) []types.CacheBehaviorPerPath {
+	// An empty, non-nil slice is sent so that removing all behaviors clears them
+	cacheBehaviors := make([]types.CacheBehaviorPerPath, 0, rawCacheBehaviors.Len())
+
+	for _, v := range rawCacheBehaviors.List() {
+		m := v.(map[string]interface{})
+
+		cacheBehaviors = append(cacheBehaviors, types.CacheBehaviorPerPath{
+			Path:     aws.String(m["path"].(string)),
+			Behavior: types.BehaviorEnum(m["behavior"].(string)),
+		})
+	}
+
+	return cacheBehaviors
+}
+
+func flattenDistributionCacheBehaviors(cacheBehaviors []types.CacheBehaviorPerPath) []interface{} {
+	var rawCacheBehaviors []interface{}
+
+	for _, v := range cacheBehaviors {
+		m := map[string]interface{}{
+			"path":     aws.ToString(v.Path),
+			"behavior": string(v.Behavior),
+		}
+		rawCacheBehaviors = append(rawCacheBehaviors, m)
+	}
+
+	return rawCacheBehaviors
+}
+
+// expandDistributionCacheSettings sends a TTL of 0 only when it is set in the configuration, an unset TTL
+// is 0 on create and leaves the Lightsail default in place.
+func expandDistributionCacheSettings(rawCacheSettings []interface{}, configuredTTLs map[string]bool) *types.CacheSettings {
+	if len(rawCacheSettings) == 0 || rawCacheSettings[0] == nil {
+		return nil
+	}
+
+	m := rawCacheSettings[0].(map[string]interface{})
+	cacheSettings := &types.CacheSettings{}
+
+	if v, ok := m["allowed_http_methods"].(string); ok && v != "" {
+		cacheSettings.AllowedHTTPMethods = aws.String(v)
+	}
+
+	if v, ok := m["cached_http_methods"].(string); ok && v != "" {
+		cacheSettings.CachedHTTPMethods = aws.String(v)
+	}
+
+	if v, ok := m["default_ttl"].(int); ok && (v != 0 || configuredTTLs["default_ttl"]) {
+		cacheSettings.DefaultTTL = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["maximum_ttl"].(int); ok && (v != 0 || configuredTTLs["maximum_ttl"]) {
+		cacheSettings.MaximumTTL = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["minimum_ttl"].(int); ok && (v != 0 || configuredTTLs["minimum_ttl"]) {
+		cacheSettings.MinimumTTL = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["forwarded_cookies"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		cookies := v[0].(map[string]interface{})
+		cacheSettings.ForwardedCookies = &types.CookieObject{
+			Option:           types.ForwardValues(cookies["option"].(string)),
+			CookiesAllowList: expandStringSet(cookies["cookies_allow_list"].(*schema.Set)),
+		}
+	}
+
+	if v, ok := m["forwarded_headers"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		headers := v[0].(map[string]interface{})
+		headersAllowList := make([]types.HeaderEnum, 0)
+		for _, h := range headers["headers_allow_list"].(*schema.Set).List() {
+			headersAllowList = append(headersAllowList, types.HeaderEnum(h.(string)))
+		}
+		cacheSettings.ForwardedHeaders = &types.HeaderObject{
+			Option:           types.ForwardValues(headers["option"].(string)),
+			HeadersAllowList: headersAllowList,
+		}
+	}
+
+	if v, ok := m["forwarded_query_strings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		queryStrings := v[0].(map[string]interface{})
+		cacheSettings.ForwardedQueryStrings = &types.QueryStringObject{
+			Option:                aws.Bool(queryStrings["option"].(bool)),
+			QueryStringsAllowList: expandStringSet(queryStrings["query_strings_allowed_list"].(*schema.Set)),
+		}
+	}
+
+	return cacheSettings
+}
+
+// distributionConfiguredTTLs reports which TTLs of cache_behavior_settings are set in the configuration
+func distributionConfiguredTTLs(d *schema.ResourceData) map[string]bool {
+	configured := make(map[string]bool)
+
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return configured
+	}
+
+	rawSettings := rawConfig.GetAttr("cache_behavior_settings")
+	if !rawSettings.IsKnown() || rawSettings.IsNull() || rawSettings.LengthInt() == 0 {
+		return configured
+	}
+
+	settings := rawSettings.AsValueSlice()[0]
+	for _, key := range []string{"default_ttl", "maximum_ttl", "minimum_ttl"} {
+		configured[key] = !settings.GetAttr(key).IsNull()
+	}
+
+	return configured
+}
+
+func flattenDistributionCacheSettings(cacheSettings *types.CacheSettings) []interface{} {
+	if cacheSettings == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"allowed_http_methods": aws.ToString(cacheSettings.AllowedHTTPMethods),
+		"cached_http_methods":  aws.ToString(cacheSettings.CachedHTTPMethods),
+		"default_ttl":          int(aws.ToInt64(cacheSettings.DefaultTTL)),
+		"maximum_ttl":          int(aws.ToInt64(cacheSettings.MaximumTTL)),
+		"minimum_ttl":          int(aws.ToInt64(cacheSettings.MinimumTTL)),
+	}
+
+	if v := cacheSettings.ForwardedCookies; v != nil {
+		m["forwarded_cookies"] = []interface{}{
+			map[string]interface{}{
+				"option":             string(v.Option),
+				"cookies_allow_list": v.CookiesAllowList,
+			},
+		}
+	}
+
+	if v := cacheSettings.ForwardedHeaders; v != nil {
+		headersAllowList := make([]string, 0, len(v.HeadersAllowList))
+		for _, h := range v.HeadersAllowList {
+			headersAllowList = append(headersAllowList, string(h))
+		}
+		m["forwarded_headers"] = []interface{}{
+			map[string]interface{}{
+				"option":             string(v.Option),
+				"headers_allow_list": headersAllowList,
+			},
+		}
+	}
+
+	if v := cacheSettings.ForwardedQueryStrings; v != nil {
+		m["forwarded_query_strings"] = []interface{}{
+			map[string]interface{}{
+				"option":                     aws.ToBool(v.Option),
+				"query_strings_allowed_list": v.QueryStringsAllowList,
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandStringSet(rawSet *schema.Set) []string {
+	// An empty, non-nil slice is returned so that removing all values clears them
+	vs := make([]string, 0, rawSet.Len())
+	for _, v := range rawSet.List() {
+		vs = append(vs, v.(string))
+	}
+
+	return vs
+}
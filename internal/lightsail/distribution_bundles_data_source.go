@@ -0,0 +1,79 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDistributionBundles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDistributionBundlesRead,
+
+		Schema: map[string]*schema.Schema{
+			"bundles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bundle_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"transfer_per_month_in_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDistributionBundlesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	region := meta.(*conns.AWSClient).Region
+
+	resp, err := conn.GetDistributionBundles(context.TODO(), &lightsail.GetDistributionBundlesInput{})
+
+	if err != nil {
+		return fmt.Errorf("Error fetching Distribution Bundles: %w", err)
+	}
+
+	bundles := make([]interface{}, 0, len(resp.Bundles))
+
+	for _, v := range resp.Bundles {
+		bundles = append(bundles, map[string]interface{}{
+			"bundle_id":                aws.ToString(v.BundleId),
+			"name":                     aws.ToString(v.Name),
+			"is_active":                aws.ToBool(v.IsActive),
+			"price":                    float64(aws.ToFloat32(v.Price)),
+			"transfer_per_month_in_gb": int(aws.ToInt32(v.TransferPerMonthInGb)),
+		})
+	}
+
+	d.SetId(region)
+
+	if err := d.Set("bundles", bundles); err != nil {
+		return fmt.Errorf("error setting bundles: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package lightsail_test
+
+import (
+	"testing"
+
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDistributionBundlesDataSource_basic(t *testing.T) {
+	dsName := "data.awslightsail_distribution_bundles.all"
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testhelper.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionBundlesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsName, "id"),
+					resource.TestCheckResourceAttrSet(dsName, "bundles.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(dsName, "bundles.*", map[string]string{
+						"bundle_id": "small_1_0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccDistributionBundlesDataSourceConfig = `
+data "awslightsail_distribution_bundles" "all" {}
+`
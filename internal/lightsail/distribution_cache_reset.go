@@ -0,0 +1,103 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceDistributionCacheReset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDistributionCacheResetCreate,
+		Read:   resourceDistributionCacheResetRead,
+		Delete: resourceDistributionCacheResetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"distribution_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// additional info returned from the API
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDistributionCacheResetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	distributionName := d.Get("distribution_name").(string)
+
+	resp, err := conn.ResetDistributionCache(context.TODO(), &lightsail.ResetDistributionCacheInput{
+		DistributionName: aws.String(distributionName),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if resp.Operation == nil {
+		return fmt.Errorf("No operation found for ResetDistributionCache request")
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", distributionName)))
+
+	err = waitLightsailOperation(conn, resp.Operation.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Distribution (%s) cache reset to complete: %s", distributionName, err)
+	}
+
+	return resourceDistributionCacheResetRead(d, meta)
+}
+
+func resourceDistributionCacheResetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	distributionName := d.Get("distribution_name").(string)
+
+	resp, err := conn.GetDistributionLatestCacheReset(context.TODO(), &lightsail.GetDistributionLatestCacheResetInput{
+		DistributionName: aws.String(distributionName),
+	})
+
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Distribution Cache Reset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Distribution Cache Reset (%s): %w", d.Id(), err)
+	}
+
+	if resp.CreateTime != nil {
+		d.Set("create_time", resp.CreateTime.Format(time.RFC3339))
+	}
+	d.Set("status", resp.Status)
+
+	return nil
+}
+
+func resourceDistributionCacheResetDelete(d *schema.ResourceData, meta interface{}) error {
+	// A cache reset can not be undone, removing the resource only removes it from state
+	return nil
+}
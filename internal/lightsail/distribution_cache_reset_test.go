@@ -0,0 +1,80 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDistributionCacheReset_basic(t *testing.T) {
+	rName := "awslightsail_distribution_cache_reset.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+	bName := acctest.RandomWithPrefix("tf-acc-test")
+	var firstId string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionCacheResetConfigBasic(lName, bName, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "distribution_name", lName),
+					resource.TestCheckResourceAttr(rName, "triggers.content_hash", "v1"),
+					resource.TestCheckResourceAttrSet(rName, "create_time"),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+					testAccCheckDistributionCacheResetId(rName, &firstId),
+				),
+			},
+			{
+				Config: testAccDistributionCacheResetConfigBasic(lName, bName, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "triggers.content_hash", "v2"),
+					testAccCheckDistributionCacheResetReplaced(rName, &firstId),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDistributionCacheResetId(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckDistributionCacheResetReplaced(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == *id {
+			return fmt.Errorf("Distribution Cache Reset (%s) was not replaced when triggers changed", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDistributionCacheResetConfigBasic(lName string, bName string, contentHash string) string {
+	return testAccDistributionConfigBasic(lName, bName) + fmt.Sprintf(`
+resource "awslightsail_distribution_cache_reset" "test" {
+  distribution_name = awslightsail_distribution.test.name
+  triggers = {
+    content_hash = %[1]q
+  }
+}
+`, contentHash)
+}
@@ -0,0 +1,424 @@
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/smithy-go"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// AWS Accounts are limited in the number of Distributions per account.
+func TestAccDistribution_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Distribution": {
+			"basic":         testAccDistribution_basic,
+			"IsEnabled":     testAccDistribution_IsEnabled,
+			"BundleId":      testAccDistribution_BundleId,
+			"CacheBehavior": testAccDistribution_CacheBehavior,
+			"Tags":          testAccDistribution_Tags,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccDistribution_basic(t *testing.T) {
+	rName := "awslightsail_distribution.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+	bName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfigBasic(lName, bName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionExists(rName),
+					resource.TestCheckResourceAttr(rName, "name", lName),
+					resource.TestCheckResourceAttr(rName, "bundle_id", "small_1_0"),
+					resource.TestCheckResourceAttr(rName, "is_enabled", "true"),
+					resource.TestCheckResourceAttr(rName, "ip_address_type", "dualstack"),
+					resource.TestCheckResourceAttr(rName, "origin.#", "1"),
+					resource.TestCheckResourceAttr(rName, "origin.0.name", bName),
+					resource.TestCheckResourceAttr(rName, "origin.0.resource_type", "Bucket"),
+					resource.TestCheckResourceAttr(rName, "default_cache_behavior.0.behavior", "cache"),
+					resource.TestCheckResourceAttrSet(rName, "arn"),
+					resource.TestCheckResourceAttrSet(rName, "domain_name"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDistribution_IsEnabled(t *testing.T) {
+	rName := "awslightsail_distribution.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+	bName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfigIsEnabled(lName, bName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(rName),
+					resource.TestCheckResourceAttr(rName, "is_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccDistributionConfigIsEnabled(lName, bName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(rName),
+					resource.TestCheckResourceAttr(rName, "is_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDistribution_BundleId(t *testing.T) {
+	rName := "awslightsail_distribution.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+	bName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfigBundleId(lName, bName, "small_1_0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(rName),
+					resource.TestCheckResourceAttr(rName, "bundle_id", "small_1_0"),
+					resource.TestCheckResourceAttrSet(rName, "able_to_update_bundle"),
+				),
+			},
+			{
+				Config: testAccDistributionConfigBundleId(lName, bName, "medium_1_0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(rName),
+					resource.TestCheckResourceAttr(rName, "bundle_id", "medium_1_0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDistribution_CacheBehavior(t *testing.T) {
+	rName := "awslightsail_distribution.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+	bName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfigCacheBehavior(lName, bName, "dont-cache"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(rName),
+					resource.TestCheckResourceAttr(rName, "cache_behavior.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "cache_behavior.*", map[string]string{
+						"path":     "/admin/*",
+						"behavior": "dont-cache",
+					}),
+					resource.TestCheckResourceAttr(rName, "cache_behavior_settings.0.default_ttl", "86400"),
+					resource.TestCheckResourceAttr(rName, "cache_behavior_settings.0.forwarded_headers.0.option", "allow-list"),
+					resource.TestCheckResourceAttr(rName, "cache_behavior_settings.0.forwarded_headers.0.headers_allow_list.#", "1"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDistributionConfigCacheBehavior(lName, bName, "cache"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(rName),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "cache_behavior.*", map[string]string{
+						"path":     "/admin/*",
+						"behavior": "cache",
+					}),
+				),
+			},
+			{
+				Config: testAccDistributionConfigCacheBehaviorZeroTTL(lName, bName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(rName),
+					resource.TestCheckResourceAttr(rName, "cache_behavior_settings.0.default_ttl", "0"),
+					resource.TestCheckResourceAttr(rName, "cache_behavior_settings.0.minimum_ttl", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDistribution_Tags(t *testing.T) {
+	rName := "awslightsail_distribution.test"
+	lName := acctest.RandomWithPrefix("tf-acc-test")
+	bName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfigTags1(lName, bName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(rName),
+					resource.TestCheckResourceAttr(rName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(rName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccDistributionConfigTags1(lName, bName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(rName),
+					resource.TestCheckResourceAttr(rName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(rName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDistributionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Distribution ID is set")
+		}
+
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+		resp, err := conn.GetDistributions(context.TODO(), &lightsail.GetDistributionsInput{
+			DistributionName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || len(resp.Distributions) == 0 {
+			return fmt.Errorf("Distribution (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDistributionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "awslightsail_distribution" {
+			continue
+		}
+
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+		resp, err := conn.GetDistributions(context.TODO(), &lightsail.GetDistributionsInput{
+			DistributionName: aws.String(rs.Primary.ID),
+		})
+
+		if err == nil {
+			if len(resp.Distributions) > 0 {
+				return fmt.Errorf("Distribution %q still exists", rs.Primary.ID)
+			}
+		}
+
+		// Verify the error
+		if err != nil {
+			var oe *smithy.OperationError
+			if errors.As(err, &oe) {
+				log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
+			}
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func testAccDistributionConfigBase(bName string) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_bucket" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+}
+`, bName)
+}
+
+func testAccDistributionConfigBasic(lName string, bName string) string {
+	return testAccDistributionConfigBase(bName) + fmt.Sprintf(`
+resource "awslightsail_distribution" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+  origin {
+    name        = awslightsail_bucket.test.name
+    region_name = data.awslightsail_availability_zones.all.id
+  }
+  default_cache_behavior {
+    behavior = "cache"
+  }
+}
+`, lName)
+}
+
+func testAccDistributionConfigBundleId(lName string, bName string, bundleId string) string {
+	return testAccDistributionConfigBase(bName) + fmt.Sprintf(`
+resource "awslightsail_distribution" "test" {
+  name      = %[1]q
+  bundle_id = %[2]q
+  origin {
+    name        = awslightsail_bucket.test.name
+    region_name = data.awslightsail_availability_zones.all.id
+  }
+  default_cache_behavior {
+    behavior = "cache"
+  }
+}
+`, lName, bundleId)
+}
+
+func testAccDistributionConfigIsEnabled(lName string, bName string, isEnabled bool) string {
+	return testAccDistributionConfigBase(bName) + fmt.Sprintf(`
+resource "awslightsail_distribution" "test" {
+  name       = %[1]q
+  bundle_id  = "small_1_0"
+  is_enabled = %[2]t
+  origin {
+    name        = awslightsail_bucket.test.name
+    region_name = data.awslightsail_availability_zones.all.id
+  }
+  default_cache_behavior {
+    behavior = "cache"
+  }
+}
+`, lName, isEnabled)
+}
+
+func testAccDistributionConfigCacheBehavior(lName string, bName string, behavior string) string {
+	return testAccDistributionConfigBase(bName) + fmt.Sprintf(`
+resource "awslightsail_distribution" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+  origin {
+    name        = awslightsail_bucket.test.name
+    region_name = data.awslightsail_availability_zones.all.id
+  }
+  default_cache_behavior {
+    behavior = "cache"
+  }
+  cache_behavior_settings {
+    allowed_http_methods = "GET,HEAD,OPTIONS"
+    cached_http_methods  = "GET,HEAD"
+    default_ttl          = 86400
+    maximum_ttl          = 31536000
+    minimum_ttl          = 0
+    forwarded_cookies {
+      option = "none"
+    }
+    forwarded_headers {
+      option             = "allow-list"
+      headers_allow_list = ["Host"]
+    }
+    forwarded_query_strings {
+      option = false
+    }
+  }
+  cache_behavior {
+    path     = "/admin/*"
+    behavior = %[2]q
+  }
+}
+`, lName, behavior)
+}
+
+func testAccDistributionConfigCacheBehaviorZeroTTL(lName string, bName string) string {
+	return testAccDistributionConfigBase(bName) + fmt.Sprintf(`
+resource "awslightsail_distribution" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+  origin {
+    name        = awslightsail_bucket.test.name
+    region_name = data.awslightsail_availability_zones.all.id
+  }
+  default_cache_behavior {
+    behavior = "cache"
+  }
+  cache_behavior_settings {
+    allowed_http_methods = "GET,HEAD,OPTIONS"
+    cached_http_methods  = "GET,HEAD"
+    default_ttl          = 0
+    maximum_ttl          = 31536000
+    minimum_ttl          = 0
+    forwarded_cookies {
+      option = "none"
+    }
+    forwarded_headers {
+      option             = "allow-list"
+      headers_allow_list = ["Host"]
+    }
+    forwarded_query_strings {
+      option = false
+    }
+  }
+  cache_behavior {
+    path     = "/admin/*"
+    behavior = "cache"
+  }
+}
+`, lName)
+}
+
+func testAccDistributionConfigTags1(lName string, bName string, tagKey1, tagValue1 string) string {
+	return testAccDistributionConfigBase(bName) + fmt.Sprintf(`
+resource "awslightsail_distribution" "test" {
+  name      = %[1]q
+  bundle_id = "small_1_0"
+  origin {
+    name        = awslightsail_bucket.test.name
+    region_name = data.awslightsail_availability_zones.all.id
+  }
+  default_cache_behavior {
+    behavior = "cache"
+  }
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, lName, tagKey1, tagValue1)
+}
@@ -3,12 +3,17 @@ package lightsail
 import (
 	"context"
 	"fmt"
+	"log"
+	"net"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,9 +22,47 @@ func ResourceDomainEntry() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDomainEntryCreate,
 		Read:   resourceDomainEntryRead,
+		Update: resourceDomainEntryUpdate,
 		Delete: resourceDomainEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDomainEntryImport,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceDomainEntryV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceDomainEntryStateUpgradeV0,
+				Version: 0,
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
+			"alias_target": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"is_alias"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_service_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: domainEntryAliasTargetKeys,
+						},
+						"distribution_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: domainEntryAliasTargetKeys,
+						},
+						"lb_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: domainEntryAliasTargetKeys,
+						},
+					},
+				},
+			},
 			"domain_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -29,23 +72,36 @@ func ResourceDomainEntry() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
-				ForceNew: true,
+				// Entries with an alias_target are always aliases
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return len(d.Get("alias_target").([]interface{})) > 0
+				},
 			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					domainName := d.Get("domain_name").(string)
+					return normalizeDomainEntryName(old, domainName) == normalizeDomainEntryName(new, domainName)
+				},
 			},
 			"target": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"alias_target", "target"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					recordType := d.Get("type").(string)
+					return normalizeDomainEntryTarget(recordType, old) == normalizeDomainEntryTarget(recordType, new)
+				},
 			},
 			"type": {
 				Type:     schema.TypeString,
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"A",
+					"AAAA",
 					"CNAME",
 					"MX",
 					"NS",
@@ -56,18 +112,90 @@ func ResourceDomainEntry() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+		CustomizeDiff: resourceDomainEntryTargetDiff,
+	}
+}
+
+var domainEntryAliasTargetKeys = []string{
+	"alias_target.0.container_service_name",
+	"alias_target.0.distribution_name",
+	"alias_target.0.lb_name",
+}
+
+// resourceDomainEntryTargetDiff validates the target against the format of the record type. The target of
+// an alias_target is resolved on every plan, so the entry follows changes to the DNS name of the resource.
+func resourceDomainEntryTargetDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("alias_target") {
+		return diff.SetNewComputed("target")
+	}
+
+	if v := diff.Get("alias_target").([]interface{}); len(v) > 0 && v[0] != nil {
+		return resourceDomainEntryAliasTargetDiff(diff, meta, v[0].(map[string]interface{}))
+	}
+
+	if !diff.NewValueKnown("type") || !diff.NewValueKnown("target") || !diff.NewValueKnown("is_alias") {
+		return nil
+	}
+
+	// Alias targets are DNS names of other Lightsail resources, whatever the record type.
+	if diff.Get("is_alias").(bool) {
+		return nil
+	}
+
+	recordType := diff.Get("type").(string)
+
+	return validateDomainEntryTarget(recordType, normalizeDomainEntryTarget(recordType, diff.Get("target").(string)))
+}
+
+func resourceDomainEntryAliasTargetDiff(diff *schema.ResourceDiff, meta interface{}, m map[string]interface{}) error {
+	if recordType := diff.Get("type").(string); recordType != "A" && recordType != "AAAA" {
+		return fmt.Errorf("alias_target can only be used with A and AAAA records, got %s", recordType)
+	}
+
+	for _, k := range []string{"container_service_name", "distribution_name", "lb_name"} {
+		if !diff.NewValueKnown(fmt.Sprintf("alias_target.0.%s", k)) {
+			return diff.SetNewComputed("target")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).LightsailConn
+	target, err := resolveDomainEntryAliasTarget(conn, m)
+
+	// The resource is created in the same apply, the target is resolved on create
+	if errs.IsNotFound(err) {
+		return diff.SetNewComputed("target")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if target == "" {
+		return diff.SetNewComputed("target")
 	}
+
+	if target != diff.Get("target").(string) {
+		return diff.SetNew("target", target)
+	}
+
+	return nil
 }
 
 func resourceDomainEntryCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LightsailConn
+
+	target, isAlias, err := expandDomainEntryTarget(conn, d)
+	if err != nil {
+		return err
+	}
+
 	req := &lightsail.CreateDomainEntryInput{
 		DomainName: aws.String(d.Get("domain_name").(string)),
 
 		DomainEntry: &types.DomainEntry{
-			IsAlias: aws.Bool(d.Get("is_alias").(bool)),
-			Name:    aws.String(fmt.Sprintf("%s.%s", d.Get("name").(string), d.Get("domain_name").(string))),
-			Target:  aws.String(d.Get("target").(string)),
+			IsAlias: aws.Bool(isAlias),
+			Name:    aws.String(expandDomainEntryName(d.Get("name").(string), d.Get("domain_name").(string))),
+			Target:  aws.String(target),
 			Type:    aws.String(d.Get("type").(string)),
 		},
 	}
@@ -85,56 +213,40 @@ func resourceDomainEntryCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error waiting for Domain Entry (%s) to become ready: %s", d.Id(), err)
 	}
 
-	// Generate an ID
-	vars := []string{
-		d.Get("name").(string),
-		d.Get("domain_name").(string),
-		d.Get("type").(string),
-		d.Get("target").(string),
-	}
-
-	d.SetId(strings.Join(vars, "_"))
+	d.Set("target", target)
+	d.SetId(domainEntryId(d))
 
 	return resourceDomainEntryRead(d, meta)
 }
 
 func resourceDomainEntryRead(d *schema.ResourceData, meta interface{}) error {
-
-	id_parts := strings.SplitN(d.Id(), "_", -1)
-	if len(id_parts) != 4 {
-		return nil
+	entryName, domainname, recordType, recordTarget, err := expandDomainEntryId(d.Id())
+	if err != nil {
+		return err
 	}
-	name := fmt.Sprintf("%s.%s", id_parts[0], id_parts[1])
-	domainname := id_parts[1]
-	recordType := id_parts[2]
-	recordTarget := id_parts[3]
+
+	name := expandDomainEntryName(entryName, domainname)
 
 	conn := meta.(*conns.AWSClient).LightsailConn
-	resp, err := conn.GetDomain(context.TODO(), &lightsail.GetDomainInput{
-		DomainName: aws.String(domainname),
-	})
+	entry, err := findDomainEntry(conn, domainname, name, recordType, recordTarget)
 
-	if err != nil {
-		return err
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Domain Entry (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
 
-	var entry types.DomainEntry
-	entryExists := false
-
-	for _, n := range resp.Domain.DomainEntries {
-		if name == *n.Name && recordType == *n.Type && recordTarget == *n.Target {
-			entry = n
-			entryExists = true
-			break
-		}
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Domain Entry (%s): %w", d.Id(), err)
 	}
 
-	if !entryExists {
+	if entry == nil {
+		log.Printf("[WARN] Lightsail Domain Entry (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	d.Set("name", strings.TrimSuffix(aws.ToString(entry.Name), fmt.Sprintf(".%s", domainname)))
+	d.Set("name", flattenDomainEntryName(aws.ToString(entry.Name), domainname))
 	d.Set("domain_name", domainname)
 	d.Set("type", entry.Type)
 	d.Set("is_alias", entry.IsAlias)
@@ -143,24 +255,416 @@ func resourceDomainEntryRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceDomainEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	domainName := d.Get("domain_name").(string)
+	name := expandDomainEntryName(d.Get("name").(string), domainName)
+	oldTarget, _ := d.GetChange("target")
+
+	// UpdateDomainEntry identifies the entry by its Lightsail ID, which is looked up with the current target.
+	entry, err := findDomainEntry(conn, domainName, name, d.Get("type").(string), normalizeDomainEntryTarget(d.Get("type").(string), oldTarget.(string)))
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Domain Entry (%s): %w", d.Id(), err)
+	}
+
+	if entry == nil {
+		return fmt.Errorf("Lightsail Domain Entry (%s) not found", d.Id())
+	}
+
+	target, isAlias, err := expandDomainEntryTarget(conn, d)
+	if err != nil {
+		return err
+	}
+
+	if err := updateDomainEntry(conn, domainName, entry, target, isAlias); err != nil {
+		return err
+	}
+
+	d.Set("target", target)
+
+	// The ID includes the target, so it changes along with it
+	d.SetId(domainEntryId(d))
+
+	return resourceDomainEntryRead(d, meta)
+}
+
 func resourceDomainEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	entryName, domainname, recordType, recordTarget, err := expandDomainEntryId(d.Id())
+	if err != nil {
+		return err
+	}
 
-	id_parts := strings.SplitN(d.Id(), "_", -1)
-	name := fmt.Sprintf("%s.%s", id_parts[0], id_parts[1])
-	domainname := id_parts[1]
-	recordType := id_parts[2]
-	recordTarget := id_parts[3]
+	name := expandDomainEntryName(entryName, domainname)
 
 	conn := meta.(*conns.AWSClient).LightsailConn
-	_, err := conn.DeleteDomainEntry(context.TODO(), &lightsail.DeleteDomainEntryInput{
+
+	// The entry is deleted with the name and target stored by Lightsail, which may differ from the normalized ID
+	entry, err := findDomainEntry(conn, domainname, name, recordType, recordTarget)
+
+	if errs.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Domain Entry (%s): %w", d.Id(), err)
+	}
+
+	if entry == nil {
+		return nil
+	}
+
+	_, err = conn.DeleteDomainEntry(context.TODO(), &lightsail.DeleteDomainEntryInput{
 		DomainName: aws.String(domainname),
 		DomainEntry: &types.DomainEntry{
-			Name:    aws.String(name),
-			Type:    aws.String(recordType),
-			Target:  aws.String(recordTarget),
+			Name:    entry.Name,
+			Type:    entry.Type,
+			Target:  entry.Target,
 			IsAlias: aws.Bool(d.Get("is_alias").(bool)),
 		},
 	})
 
 	return err
 }
+
+// expandDomainEntryTarget returns the normalized target of the entry and whether it is an alias,
+// the target of an alias_target is the current DNS name of the resource
+func expandDomainEntryTarget(conn *lightsail.Client, d *schema.ResourceData) (string, bool, error) {
+	if v := d.Get("alias_target").([]interface{}); len(v) > 0 && v[0] != nil {
+		target, err := resolveDomainEntryAliasTarget(conn, v[0].(map[string]interface{}))
+		if err != nil {
+			return "", false, fmt.Errorf("error resolving alias_target of Lightsail Domain Entry: %w", err)
+		}
+
+		if target == "" {
+			return "", false, fmt.Errorf("alias_target of Lightsail Domain Entry has no DNS name yet")
+		}
+
+		return target, true, nil
+	}
+
+	return normalizeDomainEntryTarget(d.Get("type").(string), d.Get("target").(string)), d.Get("is_alias").(bool), nil
+}
+
+// resolveDomainEntryAliasTarget returns the DNS name of the load balancer, distribution or container service of an alias_target
+func resolveDomainEntryAliasTarget(conn *lightsail.Client, m map[string]interface{}) (string, error) {
+	if v, ok := m["lb_name"].(string); ok && v != "" {
+		resp, err := conn.GetLoadBalancer(context.TODO(), &lightsail.GetLoadBalancerInput{
+			LoadBalancerName: aws.String(v),
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		return aws.ToString(resp.LoadBalancer.DnsName), nil
+	}
+
+	if v, ok := m["distribution_name"].(string); ok && v != "" {
+		resp, err := conn.GetDistributions(context.TODO(), &lightsail.GetDistributionsInput{
+			DistributionName: aws.String(v),
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		if len(resp.Distributions) == 0 {
+			return "", &types.NotFoundException{Message: aws.String(fmt.Sprintf("distribution (%s) not found", v))}
+		}
+
+		return aws.ToString(resp.Distributions[0].DomainName), nil
+	}
+
+	if v, ok := m["container_service_name"].(string); ok && v != "" {
+		resp, err := conn.GetContainerServices(context.TODO(), &lightsail.GetContainerServicesInput{
+			ServiceName: aws.String(v),
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		if len(resp.ContainerServices) == 0 {
+			return "", &types.NotFoundException{Message: aws.String(fmt.Sprintf("container service (%s) not found", v))}
+		}
+
+		// The URL of a container service is https://<dns name>/
+		u := strings.TrimPrefix(aws.ToString(resp.ContainerServices[0].Url), "https://")
+
+		return strings.TrimSuffix(u, "/"), nil
+	}
+
+	return "", fmt.Errorf("one of container_service_name, distribution_name or lb_name must be set in alias_target")
+}
+
+func resourceDomainEntryImport(
+	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	entryName, domainName, recordType, target, err := expandDomainEntryId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	// The ID is normalized, so the entry is found whichever way the name and target are written
+	d.Set("name", normalizeDomainEntryName(entryName, domainName))
+	d.Set("domain_name", domainName)
+	d.Set("type", recordType)
+	d.Set("target", normalizeDomainEntryTarget(recordType, target))
+	d.SetId(domainEntryId(d))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func domainEntryId(d *schema.ResourceData) string {
+	vars := []string{
+		normalizeDomainEntryName(d.Get("name").(string), d.Get("domain_name").(string)),
+		d.Get("domain_name").(string),
+		d.Get("type").(string),
+		normalizeDomainEntryTarget(d.Get("type").(string), d.Get("target").(string)),
+	}
+
+	return strings.Join(vars, ",")
+}
+
+// expandDomainEntryId returns the name, domain name, type and target of the entry. IDs created by earlier
+// versions of the provider are joined with underscores, they are rewritten by resourceDomainEntryStateUpgradeV0.
+func expandDomainEntryId(id string) (string, string, string, string, error) {
+	// The target is last, as it can contain commas itself, e.g. in TXT records
+	idParts := strings.SplitN(id, ",", 4)
+
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%s), expected NAME,DOMAIN_NAME,TYPE,TARGET", id)
+	}
+
+	return idParts[0], idParts[1], idParts[2], idParts[3], nil
+}
+
+// expandDomainEntryName returns the fully qualified name of an entry, "@" is the apex of the domain
+func expandDomainEntryName(name, domainName string) string {
+	name = normalizeDomainEntryName(name, domainName)
+
+	if name == "@" {
+		return domainName
+	}
+
+	return fmt.Sprintf("%s.%s", name, domainName)
+}
+
+// normalizeDomainEntryName returns the name relative to the domain without a trailing dot,
+// the apex of the domain is "@"
+func normalizeDomainEntryName(name, domainName string) string {
+	name = strings.TrimSuffix(name, ".")
+
+	if name == "" || name == "@" || name == domainName {
+		return "@"
+	}
+
+	return strings.TrimSuffix(name, fmt.Sprintf(".%s", domainName))
+}
+
+// normalizeDomainEntryTarget returns the target in the format stored by Lightsail. Host names lose
+// their trailing dot and TXT values are quoted, split in chunks of 255 characters.
+func normalizeDomainEntryTarget(recordType, target string) string {
+	switch recordType {
+	case "CNAME", "NS":
+		return strings.TrimSuffix(target, ".")
+	case "MX", "SRV":
+		fields := strings.Fields(target)
+		if len(fields) == 0 {
+			return target
+		}
+
+		fields[len(fields)-1] = strings.TrimSuffix(fields[len(fields)-1], ".")
+		return strings.Join(fields, " ")
+	case "TXT":
+		if strings.HasPrefix(target, `"`) {
+			return target
+		}
+
+		var chunks []string
+		for len(target) > domainEntryTxtChunkLength {
+			chunks = append(chunks, quoteDomainEntryTxt(target[:domainEntryTxtChunkLength]))
+			target = target[domainEntryTxtChunkLength:]
+		}
+		chunks = append(chunks, quoteDomainEntryTxt(target))
+
+		return strings.Join(chunks, " ")
+	}
+
+	return target
+}
+
+// domainEntryTxtChunkLength is the maximum length of a single string of a TXT record
+const domainEntryTxtChunkLength = 255
+
+var (
+	domainEntryHostRegexp    = regexp.MustCompile(`^(\*\.)?([a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?\.)*[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?$`)
+	domainEntryTxtRegexp     = regexp.MustCompile(`^"((?:[^"\\]|\\.)*)"(\s+"((?:[^"\\]|\\.)*)")*$`)
+	domainEntryTxtPartRegexp = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"`)
+)
+
+func quoteDomainEntryTxt(s string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(s, `"`, `\"`))
+}
+
+// validateDomainEntryTarget checks a normalized target matches the format of the record type
+func validateDomainEntryTarget(recordType, target string) error {
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(target); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("target %q of an A record must be an IPv4 address", target)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(target); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("target %q of an AAAA record must be an IPv6 address", target)
+		}
+	case "CNAME", "NS":
+		if !domainEntryHostRegexp.MatchString(target) {
+			return fmt.Errorf("target %q of a %s record must be a host name", target, recordType)
+		}
+	case "MX":
+		fields := strings.Fields(target)
+		if len(fields) != 2 || !isDomainEntryUint16(fields[0]) || !domainEntryHostRegexp.MatchString(fields[1]) {
+			return fmt.Errorf("target %q of an MX record must be in the format \"priority host\", e.g. \"10 mail.example.com\"", target)
+		}
+	case "SRV":
+		fields := strings.Fields(target)
+		if len(fields) != 4 || !isDomainEntryUint16(fields[0]) || !isDomainEntryUint16(fields[1]) || !isDomainEntryUint16(fields[2]) || !domainEntryHostRegexp.MatchString(fields[3]) {
+			return fmt.Errorf("target %q of an SRV record must be in the format \"priority weight port host\", e.g. \"10 5 5060 sip.example.com\"", target)
+		}
+	case "TXT":
+		if !domainEntryTxtRegexp.MatchString(target) {
+			return fmt.Errorf("target %q of a TXT record must be a list of quoted strings", target)
+		}
+
+		for _, part := range domainEntryTxtPartRegexp.FindAllStringSubmatch(target, -1) {
+			if len(part[1]) > domainEntryTxtChunkLength {
+				return fmt.Errorf("strings of a TXT record can not be longer than %d characters, unquoted values are split automatically", domainEntryTxtChunkLength)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isDomainEntryUint16(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 16)
+	return err == nil
+}
+
+// flattenDomainEntryName returns the name of an entry relative to the domain
+func flattenDomainEntryName(name, domainName string) string {
+	if name == domainName {
+		return "@"
+	}
+
+	return strings.TrimSuffix(name, fmt.Sprintf(".%s", domainName))
+}
+
+// findDomainEntry returns the entry of the domain matching the fully qualified name, type and target, or nil when there is none
+func findDomainEntry(conn *lightsail.Client, domainName, name, recordType, target string) (*types.DomainEntry, error) {
+	resp, err := conn.GetDomain(context.TODO(), &lightsail.GetDomainInput{
+		DomainName: aws.String(domainName),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, n := range resp.Domain.DomainEntries {
+		if isDomainEntry(n, name, recordType, target) {
+			entry := n
+			return &entry, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// isDomainEntry reports whether the entry has the fully qualified name, type and target. Names and targets
+// are compared normalized, so entries stored by earlier versions of the provider with a trailing dot or in
+// another case are still found. Only TXT values are case sensitive.
+func isDomainEntry(entry types.DomainEntry, name, recordType, target string) bool {
+	if recordType != aws.ToString(entry.Type) {
+		return false
+	}
+
+	if !strings.EqualFold(strings.TrimSuffix(name, "."), strings.TrimSuffix(aws.ToString(entry.Name), ".")) {
+		return false
+	}
+
+	entryTarget := normalizeDomainEntryTarget(recordType, aws.ToString(entry.Target))
+	target = normalizeDomainEntryTarget(recordType, target)
+
+	if recordType == "TXT" {
+		return entryTarget == target
+	}
+
+	return strings.EqualFold(entryTarget, target)
+}
+
+func createDomainEntry(conn *lightsail.Client, domainName string, entry *types.DomainEntry) error {
+	resp, err := conn.CreateDomainEntry(context.TODO(), &lightsail.CreateDomainEntryInput{
+		DomainName:  aws.String(domainName),
+		DomainEntry: entry,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Domain Entry (%s %s): %w", aws.ToString(entry.Name), aws.ToString(entry.Type), err)
+	}
+
+	err = waitLightsailOperation(conn, resp.Operation.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Domain Entry (%s %s) to become ready: %s", aws.ToString(entry.Name), aws.ToString(entry.Type), err)
+	}
+
+	return nil
+}
+
+// updateDomainEntry changes the target of an existing entry in place, so the record is never missing
+func updateDomainEntry(conn *lightsail.Client, domainName string, entry *types.DomainEntry, target string, isAlias bool) error {
+	resp, err := conn.UpdateDomainEntry(context.TODO(), &lightsail.UpdateDomainEntryInput{
+		DomainName: aws.String(domainName),
+		DomainEntry: &types.DomainEntry{
+			Id:      entry.Id,
+			IsAlias: aws.Bool(isAlias),
+			Name:    entry.Name,
+			Target:  aws.String(target),
+			Type:    entry.Type,
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("error updating Lightsail Domain Entry (%s %s): %w", aws.ToString(entry.Name), aws.ToString(entry.Type), err)
+	}
+
+	if len(resp.Operations) == 0 {
+		return fmt.Errorf("No operations found for UpdateDomainEntry request")
+	}
+
+	op := resp.Operations[0]
+
+	err = waitLightsailOperation(conn, op.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Domain Entry (%s %s) to be updated: %s", aws.ToString(entry.Name), aws.ToString(entry.Type), err)
+	}
+
+	return nil
+}
+
+func deleteDomainEntry(conn *lightsail.Client, domainName string, entry *types.DomainEntry) error {
+	resp, err := conn.DeleteDomainEntry(context.TODO(), &lightsail.DeleteDomainEntryInput{
+		DomainName:  aws.String(domainName),
+		DomainEntry: entry,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Domain Entry (%s %s): %w", aws.ToString(entry.Name), aws.ToString(entry.Type), err)
+	}
+
+	err = waitLightsailOperation(conn, resp.Operation.Id)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Domain Entry (%s %s) to be deleted: %s", aws.ToString(entry.Name), aws.ToString(entry.Type), err)
+	}
+
+	return nil
+}
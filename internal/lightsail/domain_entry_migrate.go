@@ -0,0 +1,74 @@
+package lightsail
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceDomainEntryV0 is the schema of domain entries whose ID may be joined with underscores
+func resourceDomainEntryV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"is_alias": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// resourceDomainEntryStateUpgradeV0 rewrites the ID of a domain entry from its attributes. IDs created by
+// earlier versions of the provider are joined with underscores, which can not be split reliably when the
+// name or target contains underscores or commas, e.g. _dmarc or "v=spf1 include:_spf.google.com ~all".
+func resourceDomainEntryStateUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	name, _ := rawState["name"].(string)
+	domainName, _ := rawState["domain_name"].(string)
+	recordType, _ := rawState["type"].(string)
+	target, _ := rawState["target"].(string)
+
+	if name == "" || domainName == "" || recordType == "" || target == "" {
+		log.Printf("[WARN] Lightsail Domain Entry (%v) is missing attributes, keeping its ID", rawState["id"])
+		return rawState, nil
+	}
+
+	id := strings.Join([]string{
+		normalizeDomainEntryName(name, domainName),
+		domainName,
+		recordType,
+		normalizeDomainEntryTarget(recordType, target),
+	}, ",")
+
+	log.Printf("[DEBUG] Upgrading Lightsail Domain Entry ID from (%v) to (%s)", rawState["id"], id)
+
+	rawState["id"] = id
+
+	return rawState, nil
+}
@@ -0,0 +1,79 @@
+package lightsail
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+)
+
+func TestResourceDomainEntryStateUpgradeV0(t *testing.T) {
+	cases := []struct {
+		Name     string
+		State    map[string]interface{}
+		Expected string
+	}{
+		{
+			"underscore name",
+			map[string]interface{}{"id": `_dmarc_example.com_TXT_"v=DMARC1; p=none"`, "name": "_dmarc", "domain_name": "example.com", "type": "TXT", "target": `"v=DMARC1; p=none"`},
+			`_dmarc,example.com,TXT,"v=DMARC1; p=none"`,
+		},
+		{
+			"underscore and comma in target",
+			map[string]interface{}{"id": `@_example.com_TXT_"v=spf1 include:_spf.google.com, ~all"`, "name": "@", "domain_name": "example.com", "type": "TXT", "target": `"v=spf1 include:_spf.google.com, ~all"`},
+			`@,example.com,TXT,"v=spf1 include:_spf.google.com, ~all"`,
+		},
+		{
+			"trailing dots",
+			map[string]interface{}{"id": "www.example.com._example.com_CNAME_example.com.", "name": "www.example.com.", "domain_name": "example.com", "type": "CNAME", "target": "example.com."},
+			"www,example.com,CNAME,example.com",
+		},
+		{
+			"apex",
+			map[string]interface{}{"id": "example.com_example.com_A_127.0.0.1", "name": "example.com", "domain_name": "example.com", "type": "A", "target": "127.0.0.1"},
+			"@,example.com,A,127.0.0.1",
+		},
+		{
+			"missing attributes",
+			map[string]interface{}{"id": "www_example.com_A_127.0.0.1"},
+			"www_example.com_A_127.0.0.1",
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := resourceDomainEntryStateUpgradeV0(context.Background(), tc.State, nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Name, err)
+		}
+
+		if actual["id"] != tc.Expected {
+			t.Errorf("%s: expected ID %q, got %q", tc.Name, tc.Expected, actual["id"])
+		}
+	}
+}
+
+func TestIsDomainEntry(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Entry      types.DomainEntry
+		EntryName  string
+		RecordType string
+		Target     string
+		Expected   bool
+	}{
+		{"exact", types.DomainEntry{Name: aws.String("www.example.com"), Type: aws.String("A"), Target: aws.String("127.0.0.1")}, "www.example.com", "A", "127.0.0.1", true},
+		{"trailing dot in API", types.DomainEntry{Name: aws.String("www.example.com."), Type: aws.String("CNAME"), Target: aws.String("example.com.")}, "www.example.com", "CNAME", "example.com", true},
+		{"case", types.DomainEntry{Name: aws.String("WWW.Example.com"), Type: aws.String("CNAME"), Target: aws.String("Example.com")}, "www.example.com", "CNAME", "example.com", true},
+		{"unquoted TXT", types.DomainEntry{Name: aws.String("example.com"), Type: aws.String("TXT"), Target: aws.String(`"v=spf1 ~all"`)}, "example.com", "TXT", "v=spf1 ~all", true},
+		{"TXT case", types.DomainEntry{Name: aws.String("example.com"), Type: aws.String("TXT"), Target: aws.String(`"ABC"`)}, "example.com", "TXT", `"abc"`, false},
+		{"other type", types.DomainEntry{Name: aws.String("www.example.com"), Type: aws.String("A"), Target: aws.String("127.0.0.1")}, "www.example.com", "AAAA", "127.0.0.1", false},
+		{"other target", types.DomainEntry{Name: aws.String("www.example.com"), Type: aws.String("A"), Target: aws.String("127.0.0.1")}, "www.example.com", "A", "127.0.0.2", false},
+	}
+
+	for _, tc := range cases {
+		if actual := isDomainEntry(tc.Entry, tc.EntryName, tc.RecordType, tc.Target); actual != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tc.Name, tc.Expected, actual)
+		}
+	}
+}
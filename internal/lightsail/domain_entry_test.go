@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -33,6 +35,151 @@ func TestAccDomainEntry_basic(t *testing.T) {
 					testAccCheckDomainEntryExists(rName),
 				),
 			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s.%s.,%s,A,127.0.0.1", lightsailDomainEntryName, lightsailDomainName, lightsailDomainName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDomainEntry_Target(t *testing.T) {
+	rName := "awslightsail_domain_entry.test"
+	lightsailDomainName := fmt.Sprintf("tf-test-lightsail-%s.com", acctest.RandString(5))
+	lightsailDomainEntryName := fmt.Sprintf("test-%s", acctest.RandString(5))
+	var entryId string
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDomainEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainEntryConfig_target(lightsailDomainName, lightsailDomainEntryName, "127.0.0.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainEntryExists(rName),
+					testAccCheckDomainEntryLightsailId(rName, &entryId, false),
+					resource.TestCheckResourceAttr(rName, "target", "127.0.0.1"),
+				),
+			},
+			{
+				Config: testAccDomainEntryConfig_target(lightsailDomainName, lightsailDomainEntryName, "127.0.0.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainEntryExists(rName),
+					testAccCheckDomainEntryLightsailId(rName, &entryId, true),
+					resource.TestCheckResourceAttr(rName, "target", "127.0.0.2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDomainEntry_RecordTypes(t *testing.T) {
+	lightsailDomainName := fmt.Sprintf("tf-test-lightsail-%s.com", acctest.RandString(5))
+	txtValue := strings.Repeat("a", 300)
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDomainEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainEntryConfig_recordTypes(lightsailDomainName, txtValue),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainEntryExists("awslightsail_domain_entry.apex"),
+					resource.TestCheckResourceAttr("awslightsail_domain_entry.apex", "name", "@"),
+					testAccCheckDomainEntryExists("awslightsail_domain_entry.aaaa"),
+					resource.TestCheckResourceAttr("awslightsail_domain_entry.aaaa", "target", "2001:db8::1"),
+					testAccCheckDomainEntryExists("awslightsail_domain_entry.cname"),
+					resource.TestCheckResourceAttr("awslightsail_domain_entry.cname", "name", "www"),
+					resource.TestCheckResourceAttr("awslightsail_domain_entry.cname", "target", lightsailDomainName),
+					testAccCheckDomainEntryExists("awslightsail_domain_entry.mx"),
+					resource.TestCheckResourceAttr("awslightsail_domain_entry.mx", "target", fmt.Sprintf("10 mail.%s", lightsailDomainName)),
+					testAccCheckDomainEntryExists("awslightsail_domain_entry.srv"),
+					resource.TestCheckResourceAttr("awslightsail_domain_entry.srv", "name", "_sip._tcp"),
+					testAccCheckDomainEntryExists("awslightsail_domain_entry.txt"),
+					resource.TestCheckResourceAttr("awslightsail_domain_entry.txt", "target", fmt.Sprintf(`"%s" "%s"`, txtValue[:255], txtValue[255:])),
+					testAccCheckDomainEntryExists("awslightsail_domain_entry.underscore"),
+					resource.TestCheckResourceAttr("awslightsail_domain_entry.underscore", "id", fmt.Sprintf(`_spf,%s,TXT,"v=spf1 include:_spf.google.com, ~all"`, lightsailDomainName)),
+				),
+			},
+			{
+				ResourceName:      "awslightsail_domain_entry.underscore",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDomainEntry_InvalidTarget(t *testing.T) {
+	lightsailDomainName := fmt.Sprintf("tf-test-lightsail-%s.com", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDomainEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainEntryConfig_typeTarget(lightsailDomainName, "A", "2001:db8::1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be an IPv4 address`),
+			},
+			{
+				Config:      testAccDomainEntryConfig_typeTarget(lightsailDomainName, "AAAA", "127.0.0.1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be an IPv6 address`),
+			},
+			{
+				Config:      testAccDomainEntryConfig_typeTarget(lightsailDomainName, "MX", "mail.example.com"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be in the format "priority host"`),
+			},
+			{
+				Config:      testAccDomainEntryConfig_typeTarget(lightsailDomainName, "SRV", "10 5 70000 sip.example.com"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be in the format "priority weight port host"`),
+			},
+			{
+				Config:      testAccDomainEntryConfig_typeTarget(lightsailDomainName, "TXT", `\"unterminated`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be a list of quoted strings`),
+			},
+		},
+	})
+}
+
+func TestAccDomainEntry_AliasTarget(t *testing.T) {
+	rName := "awslightsail_domain_entry.test"
+	lbName := "awslightsail_lb.test"
+	lightsailDomainName := fmt.Sprintf("tf-test-lightsail-%s.com", acctest.RandString(5))
+	lightsailLbName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDomainEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainEntryConfig_aliasTarget(lightsailDomainName, lightsailLbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainEntryExists(rName),
+					resource.TestCheckResourceAttr(rName, "is_alias", "true"),
+					resource.TestCheckResourceAttrPair(rName, "target", lbName, "dns_name"),
+				),
+			},
+			{
+				Config:   testAccDomainEntryConfig_aliasTarget(lightsailDomainName, lightsailLbName),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccDomainEntryConfig_aliasTargetType(lightsailDomainName, lightsailLbName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`alias_target can only be used with A and AAAA records`),
+			},
 		},
 	})
 }
@@ -103,9 +250,14 @@ func testAccCheckDomainEntryExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Domain (%s) not found", rs.Primary.Attributes["domain_name"])
 		}
 
+		name := fmt.Sprintf("%s.%s", rs.Primary.Attributes["name"], rs.Primary.Attributes["domain_name"])
+		if rs.Primary.Attributes["name"] == "@" {
+			name = rs.Primary.Attributes["domain_name"]
+		}
+
 		entryExists := false
 		for _, n := range resp.Domain.DomainEntries {
-			if fmt.Sprintf("%s.%s", rs.Primary.Attributes["name"], rs.Primary.Attributes["domain_name"]) == *n.Name && rs.Primary.Attributes["type"] == *n.Type && rs.Primary.Attributes["target"] == *n.Target {
+			if name == *n.Name && rs.Primary.Attributes["type"] == *n.Type && rs.Primary.Attributes["target"] == *n.Target {
 				entryExists = true
 				break
 			}
@@ -119,6 +271,41 @@ func testAccCheckDomainEntryExists(n string) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckDomainEntryLightsailId stores the Lightsail ID of the entry, or checks it is unchanged after an in-place update
+func testAccCheckDomainEntryLightsailId(n string, entryId *string, unchanged bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+		resp, err := conn.GetDomain(context.TODO(), &lightsail.GetDomainInput{
+			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, e := range resp.Domain.DomainEntries {
+			if fmt.Sprintf("%s.%s", rs.Primary.Attributes["name"], rs.Primary.Attributes["domain_name"]) != aws.ToString(e.Name) || rs.Primary.Attributes["type"] != aws.ToString(e.Type) {
+				continue
+			}
+
+			if unchanged && aws.ToString(e.Id) != *entryId {
+				return fmt.Errorf("Domain entry (%s) was replaced, expected ID %s, got %s", rs.Primary.Attributes["name"], *entryId, aws.ToString(e.Id))
+			}
+
+			*entryId = aws.ToString(e.Id)
+			return nil
+		}
+
+		return fmt.Errorf("Domain entry (%s) not found", rs.Primary.Attributes["name"])
+	}
+}
+
 func testAccCheckDomainEntryDestroy(s *terraform.State) error {
 
 	for _, rs := range s.RootModule().Resources {
@@ -166,3 +353,133 @@ resource "awslightsail_domain_entry" "test" {
 }
 `, lightsailDomainName, lightsailDomainEntryName)
 }
+
+func testAccDomainEntryConfig_target(lightsailDomainName, lightsailDomainEntryName, target string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_domain" "test" {
+  domain_name = %[1]q
+}
+resource "awslightsail_domain_entry" "test" {
+  domain_name = awslightsail_domain.test.domain_name
+  name        = %[2]q
+  type        = "A"
+  target      = %[3]q
+}
+`, lightsailDomainName, lightsailDomainEntryName, target)
+}
+
+func testAccDomainEntryConfig_aliasTarget(lightsailDomainName, lightsailLbName string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_domain" "test" {
+  domain_name = %[1]q
+}
+
+resource "awslightsail_lb" "test" {
+  name              = %[2]q
+  health_check_path = "/"
+  instance_port     = "80"
+}
+
+resource "awslightsail_domain_entry" "test" {
+  domain_name = awslightsail_domain.test.domain_name
+  name        = "www"
+  type        = "A"
+
+  alias_target {
+    lb_name = awslightsail_lb.test.name
+  }
+}
+`, lightsailDomainName, lightsailLbName)
+}
+
+func testAccDomainEntryConfig_aliasTargetType(lightsailDomainName, lightsailLbName string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_domain" "test" {
+  domain_name = %[1]q
+}
+
+resource "awslightsail_lb" "test" {
+  name              = %[2]q
+  health_check_path = "/"
+  instance_port     = "80"
+}
+
+resource "awslightsail_domain_entry" "test" {
+  domain_name = awslightsail_domain.test.domain_name
+  name        = "www"
+  type        = "CNAME"
+
+  alias_target {
+    lb_name = awslightsail_lb.test.name
+  }
+}
+`, lightsailDomainName, lightsailLbName)
+}
+
+func testAccDomainEntryConfig_recordTypes(lightsailDomainName, txtValue string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_domain" "test" {
+  domain_name = %[1]q
+}
+
+resource "awslightsail_domain_entry" "apex" {
+  domain_name = awslightsail_domain.test.domain_name
+  name        = "@"
+  type        = "A"
+  target      = "127.0.0.1"
+}
+
+resource "awslightsail_domain_entry" "aaaa" {
+  domain_name = awslightsail_domain.test.domain_name
+  name        = "ipv6"
+  type        = "AAAA"
+  target      = "2001:db8::1"
+}
+
+resource "awslightsail_domain_entry" "cname" {
+  domain_name = awslightsail_domain.test.domain_name
+  name        = "www.%[1]s."
+  type        = "CNAME"
+  target      = "%[1]s."
+}
+
+resource "awslightsail_domain_entry" "mx" {
+  domain_name = awslightsail_domain.test.domain_name
+  name        = "@"
+  type        = "MX"
+  target      = "10 mail.%[1]s."
+}
+
+resource "awslightsail_domain_entry" "srv" {
+  domain_name = awslightsail_domain.test.domain_name
+  name        = "_sip._tcp"
+  type        = "SRV"
+  target      = "10 5 5060 sip.%[1]s"
+}
+
+resource "awslightsail_domain_entry" "txt" {
+  domain_name = awslightsail_domain.test.domain_name
+  name        = "txt"
+  type        = "TXT"
+  target      = %[2]q
+}
+
+resource "awslightsail_domain_entry" "underscore" {
+  domain_name = awslightsail_domain.test.domain_name
+  name        = "_spf"
+  type        = "TXT"
+  target      = "v=spf1 include:_spf.google.com, ~all"
+}
+`, lightsailDomainName, txtValue)
+}
+
+func testAccDomainEntryConfig_typeTarget(lightsailDomainName, recordType, target string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_domain_entry" "test" {
+  domain_name = %[1]q
+  name        = "test"
+  type        = %[2]q
+  target      = "%[3]s"
+}
+`, lightsailDomainName, recordType, target)
+}
@@ -0,0 +1,304 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/create"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDomainRecords() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainRecordsPut,
+		Read:   resourceDomainRecordsRead,
+		Update: resourceDomainRecordsPut,
+		Delete: resourceDomainRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"record": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      domainRecordHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"A",
+								"AAAA",
+								"CNAME",
+								"MX",
+								"NS",
+								"SOA",
+								"SRV",
+								"TXT",
+							}, false),
+						},
+						"target": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"is_alias": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: resourceDomainRecordsTargetDiff,
+	}
+}
+
+// resourceDomainRecordsTargetDiff validates that the name of each record is relative to the domain, and the
+// target against the format of the record type. Records are hashed without the domain, so a fully qualified
+// name would never match the relative name read back from Lightsail.
+func resourceDomainRecordsTargetDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("record") {
+		return nil
+	}
+
+	domainName := diff.Get("domain_name").(string)
+	domainNameKnown := diff.NewValueKnown("domain_name")
+
+	for _, raw := range diff.Get("record").(*schema.Set).List() {
+		m := raw.(map[string]interface{})
+		recordType := m["type"].(string)
+
+		if domainNameKnown && isDomainEntryFQDN(m["name"].(string), domainName) {
+			return fmt.Errorf("record %s %s: name must be relative to the domain %s, use %q", m["name"].(string), recordType, domainName, normalizeDomainEntryName(m["name"].(string), domainName))
+		}
+
+		if m["is_alias"].(bool) {
+			continue
+		}
+
+		if err := validateDomainEntryTarget(recordType, normalizeDomainEntryTarget(recordType, m["target"].(string))); err != nil {
+			return fmt.Errorf("record %s %s: %w", m["name"].(string), recordType, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDomainRecordsPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+	domainName := d.Get("domain_name").(string)
+
+	current, err := getDomainRecords(conn, domainName)
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Domain (%s) entries: %w", domainName, err)
+	}
+
+	desired := expandDomainRecords(d.Get("record").(*schema.Set).List(), domainName)
+
+	var add []types.DomainEntry
+	remove := make([]types.DomainEntry, 0, len(current))
+
+	for _, e := range current {
+		if !containsDomainRecord(desired, e) {
+			remove = append(remove, e)
+		}
+	}
+
+	for _, e := range desired {
+		if !containsDomainRecord(current, e) {
+			add = append(add, e)
+		}
+	}
+
+	// Entries with the same name and type are updated in place to avoid gaps in resolution.
+	for i := 0; i < len(add); i++ {
+		for j, r := range remove {
+			if aws.ToString(r.Name) != aws.ToString(add[i].Name) || aws.ToString(r.Type) != aws.ToString(add[i].Type) {
+				continue
+			}
+
+			log.Printf("[DEBUG] Updating Lightsail Domain (%s) entry %s %s", domainName, aws.ToString(r.Name), aws.ToString(r.Type))
+
+			if err := updateDomainEntry(conn, domainName, &r, aws.ToString(add[i].Target), aws.ToBool(add[i].IsAlias)); err != nil {
+				return err
+			}
+
+			remove = append(remove[:j], remove[j+1:]...)
+			add = append(add[:i], add[i+1:]...)
+			i--
+			break
+		}
+	}
+
+	for i := range add {
+		log.Printf("[DEBUG] Creating Lightsail Domain (%s) entry %s %s", domainName, aws.ToString(add[i].Name), aws.ToString(add[i].Type))
+
+		if err := createDomainEntry(conn, domainName, &add[i]); err != nil {
+			return err
+		}
+	}
+
+	for i := range remove {
+		log.Printf("[DEBUG] Deleting Lightsail Domain (%s) entry %s %s", domainName, aws.ToString(remove[i].Name), aws.ToString(remove[i].Type))
+
+		if err := deleteDomainEntry(conn, domainName, &remove[i]); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(domainName)
+
+	return resourceDomainRecordsRead(d, meta)
+}
+
+func resourceDomainRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	entries, err := getDomainRecords(conn, d.Id())
+
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Domain Records (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Domain Records (%s): %w", d.Id(), err)
+	}
+
+	d.Set("domain_name", d.Id())
+
+	if err := d.Set("record", flattenDomainRecords(entries, d.Id())); err != nil {
+		return fmt.Errorf("error setting record: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDomainRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LightsailConn
+
+	entries, err := getDomainRecords(conn, d.Id())
+
+	if errs.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Domain Records (%s): %w", d.Id(), err)
+	}
+
+	for i := range entries {
+		if err := deleteDomainEntry(conn, d.Id(), &entries[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getDomainRecords returns the entries of the domain, except the NS and SOA entries of the apex which are managed by Lightsail
+func getDomainRecords(conn *lightsail.Client, domainName string) ([]types.DomainEntry, error) {
+	resp, err := conn.GetDomain(context.TODO(), &lightsail.GetDomainInput{
+		DomainName: aws.String(domainName),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]types.DomainEntry, 0, len(resp.Domain.DomainEntries))
+
+	for _, e := range resp.Domain.DomainEntries {
+		if aws.ToString(e.Name) == domainName && (aws.ToString(e.Type) == "NS" || aws.ToString(e.Type) == "SOA") {
+			continue
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+func containsDomainRecord(entries []types.DomainEntry, entry types.DomainEntry) bool {
+	for _, e := range entries {
+		if aws.ToString(e.Name) == aws.ToString(entry.Name) && aws.ToString(e.Type) == aws.ToString(entry.Type) && aws.ToString(e.Target) == aws.ToString(entry.Target) && aws.ToBool(e.IsAlias) == aws.ToBool(entry.IsAlias) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandDomainRecords(tfList []interface{}, domainName string) []types.DomainEntry {
+	entries := make([]types.DomainEntry, 0, len(tfList))
+
+	for _, raw := range tfList {
+		m := raw.(map[string]interface{})
+
+		entries = append(entries, types.DomainEntry{
+			IsAlias: aws.Bool(m["is_alias"].(bool)),
+			Name:    aws.String(expandDomainEntryName(m["name"].(string), domainName)),
+			Target:  aws.String(normalizeDomainEntryTarget(m["type"].(string), m["target"].(string))),
+			Type:    aws.String(m["type"].(string)),
+		})
+	}
+
+	return entries
+}
+
+func flattenDomainRecords(entries []types.DomainEntry, domainName string) []interface{} {
+	tfList := make([]interface{}, 0, len(entries))
+
+	for _, e := range entries {
+		tfList = append(tfList, map[string]interface{}{
+			"is_alias": aws.ToBool(e.IsAlias),
+			"name":     flattenDomainEntryName(aws.ToString(e.Name), domainName),
+			"target":   aws.ToString(e.Target),
+			"type":     aws.ToString(e.Type),
+		})
+	}
+
+	return tfList
+}
+
+// isDomainEntryFQDN reports whether the name includes the domain, e.g. www.example.com or example.com.
+func isDomainEntryFQDN(name, domainName string) bool {
+	name = strings.TrimSuffix(name, ".")
+
+	return name == domainName || strings.HasSuffix(name, fmt.Sprintf(".%s", domainName))
+}
+
+// domainRecordHash hashes the normalized record, so equivalent names and targets do not cause a diff
+func domainRecordHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	recordType := m["type"].(string)
+
+	// The domain is not known here, names are compared without it.
+	name := strings.TrimSuffix(m["name"].(string), ".")
+	if name == "" {
+		name = "@"
+	}
+
+	return create.StringHashcode(fmt.Sprintf("%s-%s-%s-%t", name, recordType, normalizeDomainEntryTarget(recordType, m["target"].(string)), m["is_alias"].(bool)))
+}
@@ -0,0 +1,209 @@
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDomainRecords_basic(t *testing.T) {
+	rName := "awslightsail_domain_records.test"
+	lName := fmt.Sprintf("tf-test-lightsail-%s.com", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainRecordsConfigBasic(lName, "127.0.0.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainRecordsCount(rName, 2),
+					resource.TestCheckResourceAttr(rName, "domain_name", lName),
+					resource.TestCheckResourceAttr(rName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "record.*", map[string]string{
+						"name":   "www",
+						"type":   "A",
+						"target": "127.0.0.1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "record.*", map[string]string{
+						"name":   "@",
+						"type":   "A",
+						"target": "127.0.0.1",
+					}),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainRecordsConfigBasic(lName, "127.0.0.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainRecordsCount(rName, 2),
+					resource.TestCheckResourceAttr(rName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "record.*", map[string]string{
+						"name":   "www",
+						"type":   "A",
+						"target": "127.0.0.2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDomainRecords_FQDNName(t *testing.T) {
+	lName := fmt.Sprintf("tf-test-lightsail-%s.com", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainRecordsConfigName(lName, fmt.Sprintf("www.%s", lName)),
+				ExpectError: regexp.MustCompile(`name must be relative to the domain`),
+			},
+			{
+				Config:      testAccDomainRecordsConfigName(lName, fmt.Sprintf("%s.", lName)),
+				ExpectError: regexp.MustCompile(`name must be relative to the domain`),
+			},
+		},
+	})
+}
+
+func TestAccDomainRecords_StrayRecord(t *testing.T) {
+	rName := "awslightsail_domain_records.test"
+	lName := fmt.Sprintf("tf-test-lightsail-%s.com", acctest.RandString(5))
+
+	createStrayRecord := func(*terraform.State) error {
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+		_, err := conn.CreateDomainEntry(context.TODO(), &lightsail.CreateDomainEntryInput{
+			DomainName: aws.String(lName),
+			DomainEntry: &types.DomainEntry{
+				Name:   aws.String(fmt.Sprintf("stray.%s", lName)),
+				Type:   aws.String("A"),
+				Target: aws.String("127.0.0.3"),
+			},
+		})
+
+		if err != nil {
+			return fmt.Errorf("error creating stray Lightsail Domain Entry: %w", err)
+		}
+
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainRecordsConfigBasic(lName, "127.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainRecordsCount(rName, 2),
+					createStrayRecord,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDomainRecordsConfigBasic(lName, "127.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainRecordsCount(rName, 2),
+					resource.TestCheckResourceAttr(rName, "record.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDomainRecordsCount checks the number of entries of the domain, ignoring the NS and SOA entries of the apex
+func testAccCheckDomainRecordsCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Lightsail Domain Records ID is set")
+		}
+
+		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
+
+		resp, err := conn.GetDomain(context.TODO(), &lightsail.GetDomainInput{
+			DomainName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		actual := 0
+		for _, e := range resp.Domain.DomainEntries {
+			if aws.ToString(e.Name) == rs.Primary.ID && (aws.ToString(e.Type) == "NS" || aws.ToString(e.Type) == "SOA") {
+				continue
+			}
+			actual++
+		}
+
+		if actual != count {
+			return fmt.Errorf("Domain (%s) has %d entries, expected %d", rs.Primary.ID, actual, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccDomainRecordsConfigBasic(lName, target string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_domain" "test" {
+  domain_name = %[1]q
+}
+
+resource "awslightsail_domain_records" "test" {
+  domain_name = awslightsail_domain.test.domain_name
+
+  record {
+    name   = "@"
+    type   = "A"
+    target = "127.0.0.1"
+  }
+
+  record {
+    name   = "www"
+    type   = "A"
+    target = %[2]q
+  }
+}
+`, lName, target)
+}
+
+func testAccDomainRecordsConfigName(lName, name string) string {
+	return fmt.Sprintf(`
+resource "awslightsail_domain" "test" {
+  domain_name = %[1]q
+}
+
+resource "awslightsail_domain_records" "test" {
+  domain_name = awslightsail_domain.test.domain_name
+
+  record {
+    name   = %[2]q
+    type   = "A"
+    target = "127.0.0.1"
+  }
+}
+`, lName, name)
+}
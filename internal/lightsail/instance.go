@@ -2,7 +2,6 @@ package lightsail
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,8 +9,9 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
-	"github.com/aws/smithy-go"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/tftags"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/verify"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -76,6 +76,7 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"monitoring": monitoringSchema(types.ResourceTypeInstance),
 
 			// additional info returned from the API
 			"arn": {
@@ -168,6 +169,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error waiting for Relational Database (%s) to become ready: %s", d.Id(), err)
 	}
 
+	if err := putMonitoringAlarms(conn, d.Id(), types.ResourceTypeInstance, d.Get("monitoring").([]interface{}), false); err != nil {
+		return err
+	}
+
 	return resourceInstanceRead(d, meta)
 }
 
@@ -180,15 +185,16 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		InstanceName: aws.String(d.Id()),
 	})
 
-	if err != nil {
-		var oe *smithy.OperationError
-		if errors.As(err, &oe) {
-			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
-		}
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Instance (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Instance (%s): %w", d.Id(), err)
+	}
+
 	if resp == nil {
 		log.Printf("[WARN] Lightsail Instance (%s) not found, nil response from server, removing from state", d.Id())
 		d.SetId("")
@@ -220,6 +226,15 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("private_ip_address", i.PrivateIpAddress)
 	d.Set("public_ip_address", i.PublicIpAddress)
 
+	monitoring, err := readMonitoringAlarms(conn, d.Id(), types.ResourceTypeInstance, d.Get("monitoring").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("monitoring", monitoring); err != nil {
+		return fmt.Errorf("error setting monitoring: %w", err)
+	}
+
 	tags := KeyValueTags(i.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -237,6 +252,10 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LightsailConn
 
+	if err := deleteMonitoringAlarms(conn, d.Id(), types.ResourceTypeInstance, d.Get("monitoring").([]interface{})); err != nil {
+		return err
+	}
+
 	resp, err := conn.DeleteInstance(context.TODO(), &lightsail.DeleteInstanceInput{
 		InstanceName: aws.String(d.Id()),
 	})
@@ -266,5 +285,14 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("monitoring") {
+		o, n := d.GetChange("monitoring")
+		if err := updateMonitoringAlarms(conn, d.Id(), types.ResourceTypeInstance, o.([]interface{}), n.([]interface{})); err != nil {
+			// The alarms have not been changed, keep the monitoring block of the prior state
+			d.Set("monitoring", o)
+			return err
+		}
+	}
+
 	return resourceInstanceRead(d, meta)
 }
@@ -0,0 +1,32 @@
+package lightsail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceInstanceMetricData() *schema.Resource {
+	return dataSourceMetricData("Instance", "instance_name", alarmMetricNames[types.ResourceTypeInstance], true, getInstanceMetricData)
+}
+
+func getInstanceMetricData(conn *lightsail.Client, req metricDataRequest) ([]types.MetricDatapoint, error) {
+	resp, err := conn.GetInstanceMetricData(context.TODO(), &lightsail.GetInstanceMetricDataInput{
+		InstanceName: aws.String(req.Name),
+		MetricName:   types.InstanceMetricName(req.MetricName),
+		Period:       req.Period,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		Statistics:   req.Statistics,
+		Unit:         req.Unit,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.MetricData, nil
+}
@@ -41,6 +41,80 @@ func TestAccInstance_basic(t *testing.T) {
 					resource.TestMatchResourceAttr(rName, "ram_size", regexp.MustCompile(`\d+(.\d+)?`)),
 				),
 			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccInstance_monitoring(t *testing.T) {
+	rName := "awslightsail_instance.instance"
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_monitoring(lName, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(rName),
+					resource.TestCheckResourceAttr(rName, "monitoring.#", "1"),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.cpu_utilization_threshold", "80"),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.burst_capacity_percentage_threshold", "20"),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.notification_triggers.#", "2"),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.alarm_names.#", "3"),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.alarm_names.0", fmt.Sprintf("%s-cpu-utilization", lName)),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.alarm_names.1", fmt.Sprintf("%s-status-check-failed", lName)),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.alarm_names.2", fmt.Sprintf("%s-burst-capacity", lName)),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_monitoring(lName, 95),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(rName),
+					resource.TestCheckResourceAttr(rName, "monitoring.0.cpu_utilization_threshold", "95"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_basic(lName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(rName),
+					resource.TestCheckResourceAttr(rName, "monitoring.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInstance_monitoringExistingAlarm(t *testing.T) {
+	rName := "awslightsail_instance.instance"
+	lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testhelper.GetProviders(),
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_existingAlarm(lName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(rName),
+					resource.TestCheckResourceAttr(rName, "monitoring.#", "0"),
+				),
+			},
+			{
+				Config:      testAccInstanceConfig_existingAlarm(lName, true),
+				ExpectError: regexp.MustCompile(`already exists with other settings`),
+			},
+			{
+				Config: testAccInstanceConfig_existingAlarm(lName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awslightsail_alarm.cpu", "threshold", "50"),
+				),
+			},
 		},
 	})
 }
@@ -236,6 +310,57 @@ resource "awslightsail_instance" "instance" {
 `, lName)
 }
 
+func testAccInstanceConfig_monitoring(lName string, cpuThreshold int) string {
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_instance" "instance" {
+  name              = "%s"
+  availability_zone = data.awslightsail_availability_zones.all.names[0]
+  blueprint_id      = "amazon_linux"
+  bundle_id         = "nano_1_0"
+
+  monitoring {
+    contact_protocols         = ["Email"]
+    notification_triggers     = ["ALARM", "OK"]
+    cpu_utilization_threshold = %d
+  }
+}
+`, lName, cpuThreshold)
+}
+
+func testAccInstanceConfig_existingAlarm(lName string, monitoring bool) string {
+	block := ""
+	if monitoring {
+		block = `
+  monitoring {
+    cpu_utilization_threshold = 90
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+data "awslightsail_availability_zones" "all" {}
+
+resource "awslightsail_instance" "instance" {
+  name              = %[1]q
+  availability_zone = data.awslightsail_availability_zones.all.names[0]
+  blueprint_id      = "amazon_linux"
+  bundle_id         = "nano_1_0"
+%[2]s
+}
+
+resource "awslightsail_alarm" "cpu" {
+  name                    = "%[1]s-cpu-utilization"
+  monitored_resource_name = awslightsail_instance.instance.name
+  metric_name             = "CPUUtilization"
+  comparison_operator     = "GreaterThanOrEqualToThreshold"
+  threshold               = 50
+  evaluation_periods      = 2
+}
+`, lName, block)
+}
+
 func testAccInstanceConfig_tags1(lName string) string {
 	return fmt.Sprintf(`
 data "awslightsail_availability_zones" "all" {}
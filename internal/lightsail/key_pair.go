@@ -2,15 +2,14 @@ package lightsail
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
-	"github.com/aws/smithy-go"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/helper/encryption"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +20,9 @@ func ResourceKeyPair() *schema.Resource {
 		Create: resourceKeyPairCreate,
 		Read:   resourceKeyPairRead,
 		Delete: resourceKeyPairDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -60,6 +62,12 @@ func ResourceKeyPair() *schema.Resource {
 				Computed: true,
 				Optional: true,
 				ForceNew: true,
+				// The public key can not be retrieved from the API, so it is missing from imported key pairs.
+				// Only imported key pairs have no public key in state, so for them any configured public key
+				// is accepted without replacement, as it can not be compared with the key in Lightsail.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
 			},
 			"private_key": {
 				Type:     schema.TypeString,
@@ -170,15 +178,16 @@ func resourceKeyPairRead(d *schema.ResourceData, meta interface{}) error {
 		KeyPairName: aws.String(d.Id()),
 	})
 
-	if err != nil {
-		var oe *smithy.OperationError
-		if errors.As(err, &oe) {
-			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
-		}
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Key Pair (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Key Pair (%s): %w", d.Id(), err)
+	}
+
 	d.Set("arn", resp.KeyPair.Arn)
 	d.Set("name", resp.KeyPair.Name)
 	d.Set("fingerprint", resp.KeyPair.Fingerprint)
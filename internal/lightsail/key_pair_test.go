@@ -35,6 +35,12 @@ func TestAccKeyPair_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(rName, "private_key"),
 				),
 			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key", "public_key"},
+			},
 		},
 	})
 }
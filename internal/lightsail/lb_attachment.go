@@ -3,12 +3,14 @@ package lightsail
 import (
 	"context"
 	"fmt"
+	"log"
 	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -74,7 +76,7 @@ func resourceLoadBalancerAttachmentCreate(d *schema.ResourceData, meta interface
 		d.Get("instance_name").(string),
 	}
 
-	d.SetId(strings.Join(vars, "_"))
+	d.SetId(strings.Join(vars, ","))
 
 	return resourceLoadBalancerAttachmentRead(d, meta)
 }
@@ -82,20 +84,23 @@ func resourceLoadBalancerAttachmentCreate(d *schema.ResourceData, meta interface
 func resourceLoadBalancerAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LightsailConn
 
-	id_parts := strings.SplitN(d.Id(), "_", -1)
-	if len(id_parts) != 2 {
-		return nil
+	lbname, iname, err := expandLoadBalancerAttachmentId(d.Id())
+	if err != nil {
+		return err
 	}
 
-	lbname := id_parts[0]
-	iname := id_parts[1]
-
 	resp, err := conn.GetLoadBalancer(context.TODO(), &lightsail.GetLoadBalancerInput{
 		LoadBalancerName: aws.String(lbname),
 	})
 
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Load Balancer Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	if err != nil {
-		return err
+		return fmt.Errorf("error reading Lightsail Load Balancer Attachment (%s): %w", d.Id(), err)
 	}
 
 	var entry string
@@ -123,14 +128,11 @@ func resourceLoadBalancerAttachmentRead(d *schema.ResourceData, meta interface{}
 func resourceLoadBalancerAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LightsailConn
 
-	id_parts := strings.SplitN(d.Id(), "_", -1)
-	if len(id_parts) != 2 {
-		return nil
+	lbname, iname, err := expandLoadBalancerAttachmentId(d.Id())
+	if err != nil {
+		return err
 	}
 
-	lbname := id_parts[0]
-	iname := id_parts[1]
-
 	req := lightsail.DetachInstancesFromLoadBalancerInput{
 		LoadBalancerName: aws.String(lbname),
 		InstanceNames:    expandInstanceNames(iname),
@@ -156,6 +158,21 @@ func resourceLoadBalancerAttachmentDelete(d *schema.ResourceData, meta interface
 	return err
 }
 
+// expandLoadBalancerAttachmentId returns the load balancer name and instance name of the attachment. IDs created
+// by earlier versions of the provider are joined with an underscore.
+func expandLoadBalancerAttachmentId(id string) (string, string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 2 {
+		idParts = strings.Split(id, "_")
+	}
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected LOAD_BALANCER_NAME,INSTANCE_NAME", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
 func expandInstanceNames(iname string) []string {
 	instanceNames := make([]string, 1)
 	instanceNames[0] = iname
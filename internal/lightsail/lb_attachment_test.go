@@ -55,6 +55,11 @@ func testAccLoadBalancerAttachment_basic(t *testing.T) {
 					testAccCheckLoadBalancerAttachmentExists(rName),
 				),
 			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -117,7 +122,7 @@ func testAccCheckLoadBalancerAttachmentExists(n string) resource.TestCheckFunc {
 
 		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
 
-		id_parts := strings.SplitN(rs.Primary.ID, "_", -1)
+		id_parts := strings.Split(rs.Primary.ID, ",")
 
 		if len(id_parts) != 2 {
 			return nil
@@ -162,7 +167,7 @@ func testAccCheckLoadBalancerAttachmentDestroy(s *terraform.State) error {
 
 		conn := testhelper.GetProvider().Meta().(*conns.AWSClient).LightsailConn
 
-		id_parts := strings.SplitN(rs.Primary.ID, "_", -1)
+		id_parts := strings.Split(rs.Primary.ID, ",")
 		if len(id_parts) != 2 {
 			return nil
 		}
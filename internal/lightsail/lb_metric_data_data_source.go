@@ -0,0 +1,32 @@
+package lightsail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceLoadBalancerMetricData() *schema.Resource {
+	return dataSourceMetricData("Load Balancer", "load_balancer_name", alarmMetricNames[types.ResourceTypeLoadBalancer], true, getLoadBalancerMetricData)
+}
+
+func getLoadBalancerMetricData(conn *lightsail.Client, req metricDataRequest) ([]types.MetricDatapoint, error) {
+	resp, err := conn.GetLoadBalancerMetricData(context.TODO(), &lightsail.GetLoadBalancerMetricDataInput{
+		LoadBalancerName: aws.String(req.Name),
+		MetricName:       types.LoadBalancerMetricName(req.MetricName),
+		Period:           req.Period,
+		StartTime:        req.StartTime,
+		EndTime:          req.EndTime,
+		Statistics:       req.Statistics,
+		Unit:             req.Unit,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.MetricData, nil
+}
@@ -0,0 +1,229 @@
+package lightsail
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// metricDataRequest holds the arguments shared by the Get*MetricData operations
+type metricDataRequest struct {
+	Name       string
+	MetricName string
+	Period     *int32
+	StartTime  *time.Time
+	EndTime    *time.Time
+	Statistics []types.MetricStatistic
+	Unit       types.MetricUnit
+}
+
+// metricDataFunc calls the Get*MetricData operation of a resource type
+type metricDataFunc func(conn *lightsail.Client, req metricDataRequest) ([]types.MetricDatapoint, error)
+
+// dataSourceMetricData returns a metric data data source of a resource type. nameKey is the argument holding
+// the name of the resource, withUnit adds the unit argument for the operations that take one.
+func dataSourceMetricData(resourceType, nameKey string, metricNames []string, withUnit bool, getMetricData metricDataFunc) *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			conn := meta.(*conns.AWSClient).LightsailConn
+			startTime, endTime := expandMetricDataTimes(d)
+
+			req := metricDataRequest{
+				Name:       d.Get(nameKey).(string),
+				MetricName: d.Get("metric_name").(string),
+				Period:     aws.Int32(int32(d.Get("period").(int))),
+				StartTime:  startTime,
+				EndTime:    endTime,
+				Statistics: expandMetricStatistics(d.Get("statistics").(*schema.Set)),
+			}
+
+			if withUnit {
+				req.Unit = types.MetricUnit(d.Get("unit").(string))
+			}
+
+			datapoints, err := getMetricData(conn, req)
+
+			if err != nil {
+				return fmt.Errorf("Error fetching %s (%s) Metric Data for metric (%s): %w", resourceType, req.Name, req.MetricName, err)
+			}
+
+			d.SetId(strings.Join([]string{req.Name, req.MetricName}, ","))
+
+			if err := d.Set("datapoints", flattenMetricDatapoints(datapoints)); err != nil {
+				return fmt.Errorf("error setting datapoints: %w", err)
+			}
+
+			return nil
+		},
+
+		Schema: metricDataSchema(nameKey, metricNames, withUnit),
+	}
+}
+
+// metricDataSchema returns the arguments and attributes shared by the metric data data sources
+func metricDataSchema(nameKey string, metricNames []string, withUnit bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		nameKey: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"metric_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(metricNames, false),
+		},
+		"period": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(60, 86400),
+		},
+		"start_time": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"end_time": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"statistics": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Average",
+					"Maximum",
+					"Minimum",
+					"SampleCount",
+					"Sum",
+				}, false),
+			},
+		},
+		"datapoints": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"average": {
+						Type:     schema.TypeFloat,
+						Computed: true,
+					},
+					"maximum": {
+						Type:     schema.TypeFloat,
+						Computed: true,
+					},
+					"minimum": {
+						Type:     schema.TypeFloat,
+						Computed: true,
+					},
+					"sample_count": {
+						Type:     schema.TypeFloat,
+						Computed: true,
+					},
+					"sum": {
+						Type:     schema.TypeFloat,
+						Computed: true,
+					},
+					"timestamp": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"unit": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+
+	if withUnit {
+		s["unit"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Seconds",
+				"Microseconds",
+				"Milliseconds",
+				"Bytes",
+				"Kilobytes",
+				"Megabytes",
+				"Gigabytes",
+				"Terabytes",
+				"Bits",
+				"Kilobits",
+				"Megabits",
+				"Gigabits",
+				"Terabits",
+				"Percent",
+				"Count",
+				"Bytes/Second",
+				"Kilobytes/Second",
+				"Megabytes/Second",
+				"Gigabytes/Second",
+				"Terabytes/Second",
+				"Bits/Second",
+				"Kilobits/Second",
+				"Megabits/Second",
+				"Gigabits/Second",
+				"Terabits/Second",
+				"Count/Second",
+				"None",
+			}, false),
+		}
+	}
+
+	return s
+}
+
+// expandMetricDataTimes returns the start and end time of the metric data request
+func expandMetricDataTimes(d *schema.ResourceData) (*time.Time, *time.Time) {
+	// The values have already been validated as RFC3339
+	startTime, _ := time.Parse(time.RFC3339, d.Get("start_time").(string))
+	endTime, _ := time.Parse(time.RFC3339, d.Get("end_time").(string))
+
+	return aws.Time(startTime), aws.Time(endTime)
+}
+
+func expandMetricStatistics(s *schema.Set) []types.MetricStatistic {
+	statistics := make([]types.MetricStatistic, 0, s.Len())
+
+	for _, v := range expandStringSet(s) {
+		statistics = append(statistics, types.MetricStatistic(v))
+	}
+
+	return statistics
+}
+
+// flattenMetricDatapoints returns the datapoints ordered by timestamp, Lightsail returns them unordered
+func flattenMetricDatapoints(datapoints []types.MetricDatapoint) []interface{} {
+	sort.Slice(datapoints, func(i, j int) bool {
+		return aws.ToTime(datapoints[i].Timestamp).Before(aws.ToTime(datapoints[j].Timestamp))
+	})
+
+	tfList := make([]interface{}, 0, len(datapoints))
+
+	for _, p := range datapoints {
+		tfList = append(tfList, map[string]interface{}{
+			"average":      aws.ToFloat64(p.Average),
+			"maximum":      aws.ToFloat64(p.Maximum),
+			"minimum":      aws.ToFloat64(p.Minimum),
+			"sample_count": aws.ToFloat64(p.SampleCount),
+			"sum":          aws.ToFloat64(p.Sum),
+			"timestamp":    aws.ToTime(p.Timestamp).Format(time.RFC3339),
+			"unit":         string(p.Unit),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,75 @@
+package lightsail_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/testhelper"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMetricDataDataSource_basic(t *testing.T) {
+	cases := []struct {
+		Name         string
+		DataSource   string
+		NameKey      string
+		NameRef      string
+		MetricName   string
+		Unit         string
+		BaseConfig   func(lName string) string
+		CheckDestroy resource.TestCheckFunc
+	}{
+		{"Instance", "awslightsail_instance_metric_data", "instance_name", "awslightsail_instance.instance.name", "CPUUtilization", "Percent", testAccInstanceConfig_basic, testAccCheckInstanceDestroy},
+		{"Database", "awslightsail_database_metric_data", "database_name", "awslightsail_database.test.id", "CPUUtilization", "Percent", testAccDatabaseConfigBasic, testAccCheckAWSDatabaseDestroy},
+		{"LoadBalancer", "awslightsail_lb_metric_data", "load_balancer_name", "awslightsail_lb.test.id", "RequestCount", "Count", testAccLoadBalancerConfigBasic, testAccCheckLoadBalancerDestroy},
+		{"ContainerService", "awslightsail_container_service_metric_data", "container_service_name", "awslightsail_container_service.test.name", "CPUUtilization", "", testAccContainerServiceConfigBasic, testAccCheckContainerServiceDestroy},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			dsName := fmt.Sprintf("data.%s.test", tc.DataSource)
+			lName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+			endTime := time.Now().UTC().Truncate(time.Minute)
+			startTime := endTime.Add(-1 * time.Hour)
+
+			resource.ParallelTest(t, resource.TestCase{
+				Providers:    testhelper.GetProviders(),
+				CheckDestroy: tc.CheckDestroy,
+				Steps: []resource.TestStep{
+					{
+						Config: tc.BaseConfig(lName) + testAccMetricDataDataSourceConfig(tc.DataSource, tc.NameKey, tc.NameRef, tc.MetricName, tc.Unit, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(dsName, "id", fmt.Sprintf("%s,%s", lName, tc.MetricName)),
+							resource.TestCheckResourceAttr(dsName, "metric_name", tc.MetricName),
+							resource.TestCheckResourceAttr(dsName, "statistics.#", "2"),
+							resource.TestCheckResourceAttrSet(dsName, "datapoints.#"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+// testAccMetricDataDataSourceConfig returns the data source of the metric, the unit is left out when empty
+func testAccMetricDataDataSourceConfig(dataSource, nameKey, nameRef, metricName, unit, startTime, endTime string) string {
+	unitArg := ""
+	if unit != "" {
+		unitArg = fmt.Sprintf("unit = %q", unit)
+	}
+
+	return fmt.Sprintf(`
+data %[1]q "test" {
+  %[2]s = %[3]s
+  metric_name = %[4]q
+  period      = 300
+  start_time  = %[5]q
+  end_time    = %[6]q
+  statistics  = ["Average", "Maximum"]
+  %[7]s
+}
+`, dataSource, nameKey, nameRef, metricName, startTime, endTime, unitArg)
+}
@@ -0,0 +1,460 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// bytesPerGb converts the FreeStorageSpace metric, which is reported in bytes, to GB
+const bytesPerGb = 1024 * 1024 * 1024
+
+// monitoringAlarm describes one of the standard alarms created by a monitoring block
+type monitoringAlarm struct {
+	// suffix is appended to the monitored resource name to build the alarm name
+	suffix             string
+	metricName         types.MetricName
+	comparisonOperator types.ComparisonOperator
+	// thresholdKey is the monitoring block attribute holding the threshold, fixed is used when empty
+	thresholdKey string
+	fixed        float64
+	// scale converts the configured threshold to the unit of the metric
+	scale float64
+}
+
+// monitoringAlarms are the standard alarms created for each resource type
+var monitoringAlarms = map[types.ResourceType][]monitoringAlarm{
+	types.ResourceTypeInstance: {
+		{
+			suffix:             "cpu-utilization",
+			metricName:         types.MetricNameCPUUtilization,
+			comparisonOperator: types.ComparisonOperatorGreaterThanOrEqualToThreshold,
+			thresholdKey:       "cpu_utilization_threshold",
+			scale:              1,
+		},
+		{
+			suffix:             "status-check-failed",
+			metricName:         types.MetricNameStatusCheckFailed,
+			comparisonOperator: types.ComparisonOperatorGreaterThanOrEqualToThreshold,
+			fixed:              1,
+		},
+		{
+			suffix:             "burst-capacity",
+			metricName:         types.MetricNameBurstCapacityPercentage,
+			comparisonOperator: types.ComparisonOperatorLessThanOrEqualToThreshold,
+			thresholdKey:       "burst_capacity_percentage_threshold",
+			scale:              1,
+		},
+	},
+	types.ResourceTypeRelationalDatabase: {
+		{
+			suffix:             "cpu-utilization",
+			metricName:         types.MetricNameCPUUtilization,
+			comparisonOperator: types.ComparisonOperatorGreaterThanOrEqualToThreshold,
+			thresholdKey:       "cpu_utilization_threshold",
+			scale:              1,
+		},
+		{
+			suffix:             "free-storage-space",
+			metricName:         types.MetricNameFreeStorageSpace,
+			comparisonOperator: types.ComparisonOperatorLessThanOrEqualToThreshold,
+			thresholdKey:       "free_storage_space_threshold",
+			scale:              bytesPerGb,
+		},
+	},
+}
+
+// monitoringSchema returns the monitoring block for the given resource type
+func monitoringSchema(resourceType types.ResourceType) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"contact_protocols": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Email",
+					"SMS",
+				}, false),
+			},
+		},
+		"notification_triggers": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ALARM",
+					"OK",
+					"INSUFFICIENT_DATA",
+				}, false),
+			},
+		},
+		"evaluation_periods": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      2,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"cpu_utilization_threshold": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      90,
+			ValidateFunc: validation.FloatBetween(0, 100),
+		},
+		// additional info returned from the API
+		"alarm_names": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	switch resourceType {
+	case types.ResourceTypeInstance:
+		s["burst_capacity_percentage_threshold"] = &schema.Schema{
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      20,
+			ValidateFunc: validation.FloatBetween(0, 100),
+		}
+	case types.ResourceTypeRelationalDatabase:
+		s["free_storage_space_threshold"] = &schema.Schema{
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      2,
+			ValidateFunc: validation.FloatAtLeast(0),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func monitoringAlarmName(resourceName string, a monitoringAlarm) string {
+	return fmt.Sprintf("%s-%s", resourceName, a.suffix)
+}
+
+// getMonitoringAlarm returns the alarm with the given name, or nil when it does not exist
+func getMonitoringAlarm(conn *lightsail.Client, name string) (*types.Alarm, error) {
+	resp, err := conn.GetAlarms(context.TODO(), &lightsail.GetAlarmsInput{
+		AlarmName: aws.String(name),
+	})
+
+	if errs.IsNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Alarms) == 0 {
+		return nil, nil
+	}
+
+	return &resp.Alarms[0], nil
+}
+
+// isMonitoringAlarmOf reports whether the alarm watches the metric of the standard alarm a on the resource
+// with the same comparison, rather than being an unrelated alarm which happens to use the same name
+func isMonitoringAlarmOf(alarm *types.Alarm, resourceName string, a monitoringAlarm) bool {
+	if alarm.MonitoredResourceInfo == nil || aws.ToString(alarm.MonitoredResourceInfo.Name) != resourceName {
+		return false
+	}
+
+	return alarm.MetricName == a.metricName && alarm.ComparisonOperator == a.comparisonOperator
+}
+
+// isMonitoringAlarmPut reports whether the alarm has exactly the settings of the PutAlarm request, so that
+// putting it again does not change an alarm created outside of the monitoring block
+func isMonitoringAlarmPut(alarm *types.Alarm, input *lightsail.PutAlarmInput) bool {
+	if alarm.MonitoredResourceInfo == nil || aws.ToString(alarm.MonitoredResourceInfo.Name) != aws.ToString(input.MonitoredResourceName) {
+		return false
+	}
+
+	if alarm.MetricName != input.MetricName || alarm.ComparisonOperator != input.ComparisonOperator {
+		return false
+	}
+
+	if aws.ToFloat64(alarm.Threshold) != aws.ToFloat64(input.Threshold) || aws.ToInt32(alarm.EvaluationPeriods) != aws.ToInt32(input.EvaluationPeriods) {
+		return false
+	}
+
+	if !aws.ToBool(alarm.NotificationEnabled) {
+		return false
+	}
+
+	contactProtocols := make([]string, 0, len(alarm.ContactProtocols))
+	for _, v := range alarm.ContactProtocols {
+		contactProtocols = append(contactProtocols, string(v))
+	}
+
+	wantContactProtocols := make([]string, 0, len(input.ContactProtocols))
+	for _, v := range input.ContactProtocols {
+		wantContactProtocols = append(wantContactProtocols, string(v))
+	}
+
+	if !equalStringSets(contactProtocols, wantContactProtocols) {
+		return false
+	}
+
+	// Lightsail defaults the notification triggers to ALARM when none are given
+	if len(input.NotificationTriggers) == 0 {
+		return true
+	}
+
+	notificationTriggers := make([]string, 0, len(alarm.NotificationTriggers))
+	for _, v := range alarm.NotificationTriggers {
+		notificationTriggers = append(notificationTriggers, string(v))
+	}
+
+	wantNotificationTriggers := make([]string, 0, len(input.NotificationTriggers))
+	for _, v := range input.NotificationTriggers {
+		wantNotificationTriggers = append(wantNotificationTriggers, string(v))
+	}
+
+	return equalStringSets(notificationTriggers, wantNotificationTriggers)
+}
+
+func equalStringSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	m := make(map[string]bool, len(a))
+	for _, v := range a {
+		m[v] = true
+	}
+
+	for _, v := range b {
+		if !m[v] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// expandMonitoringAlarms returns the PutAlarm requests of the standard alarms configured by a monitoring block
+func expandMonitoringAlarms(resourceName string, resourceType types.ResourceType, m map[string]interface{}) []*lightsail.PutAlarmInput {
+	contactProtocols := make([]types.ContactProtocol, 0)
+	for _, v := range expandStringSet(m["contact_protocols"].(*schema.Set)) {
+		contactProtocols = append(contactProtocols, types.ContactProtocol(v))
+	}
+
+	var notificationTriggers []types.AlarmState
+	for _, v := range expandStringSet(m["notification_triggers"].(*schema.Set)) {
+		notificationTriggers = append(notificationTriggers, types.AlarmState(v))
+	}
+
+	var inputs []*lightsail.PutAlarmInput
+
+	for _, a := range monitoringAlarms[resourceType] {
+		threshold := a.fixed
+		if a.thresholdKey != "" {
+			threshold = m[a.thresholdKey].(float64) * a.scale
+		}
+
+		inputs = append(inputs, &lightsail.PutAlarmInput{
+			AlarmName:             aws.String(monitoringAlarmName(resourceName, a)),
+			ComparisonOperator:    a.comparisonOperator,
+			ContactProtocols:      contactProtocols,
+			EvaluationPeriods:     aws.Int32(int32(m["evaluation_periods"].(int))),
+			MetricName:            a.metricName,
+			MonitoredResourceName: aws.String(resourceName),
+			NotificationEnabled:   aws.Bool(true),
+			NotificationTriggers:  notificationTriggers,
+			Threshold:             aws.Float64(threshold),
+		})
+	}
+
+	return inputs
+}
+
+// putMonitoringAlarms creates or updates the standard alarms of the resource. Unless the alarms are managed,
+// i.e. the monitoring block is already in state, an existing alarm with the same name is only put again when
+// it has the same settings, so an alarm created outside of the monitoring block is never overwritten.
+func putMonitoringAlarms(conn *lightsail.Client, resourceName string, resourceType types.ResourceType, tfList []interface{}, managed bool) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	for _, input := range expandMonitoringAlarms(resourceName, resourceType, tfList[0].(map[string]interface{})) {
+		name := aws.ToString(input.AlarmName)
+
+		if !managed {
+			// PutAlarm overwrites an existing alarm of the same name, which must not happen to an unrelated alarm
+			existing, err := getMonitoringAlarm(conn, name)
+			if err != nil {
+				return fmt.Errorf("error reading Lightsail monitoring Alarm (%s): %w", name, err)
+			}
+
+			if existing != nil && !isMonitoringAlarmPut(existing, input) {
+				return fmt.Errorf("Lightsail Alarm (%s) already exists with other settings than the %s alarm of the monitoring block of %s, delete or rename it before configuring monitoring", name, input.MetricName, resourceName)
+			}
+		}
+
+		log.Printf("[DEBUG] Putting Lightsail monitoring Alarm (%s)", name)
+
+		resp, err := conn.PutAlarm(context.TODO(), input)
+
+		if err != nil {
+			return fmt.Errorf("error putting Lightsail monitoring Alarm (%s): %w", name, err)
+		}
+
+		if len(resp.Operations) == 0 {
+			return fmt.Errorf("No operations found for PutAlarm request")
+		}
+
+		op := resp.Operations[0]
+
+		err = waitLightsailOperation(conn, op.Id)
+		if err != nil {
+			return fmt.Errorf("Error waiting for Alarm (%s) to become ready: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+// updateMonitoringAlarms puts the standard alarms of the resource, or deletes them when the monitoring block is removed
+func updateMonitoringAlarms(conn *lightsail.Client, resourceName string, resourceType types.ResourceType, o, n []interface{}) error {
+	if len(n) == 0 || n[0] == nil {
+		return deleteMonitoringAlarms(conn, resourceName, resourceType, o)
+	}
+
+	return putMonitoringAlarms(conn, resourceName, resourceType, n, len(o) > 0 && o[0] != nil)
+}
+
+// deleteMonitoringAlarms deletes the standard alarms of the resource when the monitoring block is in state,
+// alarms which no longer exist or no longer have the settings of the block are skipped
+func deleteMonitoringAlarms(conn *lightsail.Client, resourceName string, resourceType types.ResourceType, tfList []interface{}) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	for _, input := range expandMonitoringAlarms(resourceName, resourceType, tfList[0].(map[string]interface{})) {
+		name := aws.ToString(input.AlarmName)
+
+		existing, err := getMonitoringAlarm(conn, name)
+		if err != nil {
+			return fmt.Errorf("error reading Lightsail monitoring Alarm (%s): %w", name, err)
+		}
+
+		if existing == nil {
+			continue
+		}
+
+		if !isMonitoringAlarmPut(existing, input) {
+			log.Printf("[WARN] Lightsail Alarm (%s) does not have the settings of the monitoring block of %s, skipping delete", name, resourceName)
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Lightsail monitoring Alarm (%s)", name)
+
+		resp, err := conn.DeleteAlarm(context.TODO(), &lightsail.DeleteAlarmInput{
+			AlarmName: aws.String(name),
+		})
+
+		if errs.IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting Lightsail monitoring Alarm (%s): %w", name, err)
+		}
+
+		if len(resp.Operations) == 0 {
+			continue
+		}
+
+		op := resp.Operations[0]
+
+		err = waitLightsailOperation(conn, op.Id)
+		if err != nil {
+			return fmt.Errorf("Error waiting for Alarm (%s) to be deleted: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+// readMonitoringAlarms flattens the standard alarms of the resource into a monitoring block when the block is
+// in state. The block is only returned when every standard alarm exists.
+func readMonitoringAlarms(conn *lightsail.Client, resourceName string, resourceType types.ResourceType, tfList []interface{}) ([]interface{}, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	resp, err := conn.GetAlarms(context.TODO(), &lightsail.GetAlarmsInput{
+		MonitoredResourceName: aws.String(resourceName),
+	})
+
+	if errs.IsNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Lightsail monitoring Alarms (%s): %w", resourceName, err)
+	}
+
+	alarms := make(map[string]types.Alarm, len(resp.Alarms))
+	for _, a := range resp.Alarms {
+		alarms[aws.ToString(a.Name)] = a
+	}
+
+	m := make(map[string]interface{})
+	var alarmNames []string
+
+	for _, a := range monitoringAlarms[resourceType] {
+		name := monitoringAlarmName(resourceName, a)
+
+		// A missing alarm leaves the block out of state, so that the next apply creates it again.
+		alarm, ok := alarms[name]
+		if !ok || !isMonitoringAlarmOf(&alarm, resourceName, a) {
+			log.Printf("[DEBUG] Lightsail monitoring Alarm (%s) not found", name)
+			return nil, nil
+		}
+
+		if len(alarmNames) == 0 {
+			contactProtocols := make([]string, 0, len(alarm.ContactProtocols))
+			for _, v := range alarm.ContactProtocols {
+				contactProtocols = append(contactProtocols, string(v))
+			}
+
+			notificationTriggers := make([]string, 0, len(alarm.NotificationTriggers))
+			for _, v := range alarm.NotificationTriggers {
+				notificationTriggers = append(notificationTriggers, string(v))
+			}
+
+			m["contact_protocols"] = contactProtocols
+			m["notification_triggers"] = notificationTriggers
+			m["evaluation_periods"] = int(aws.ToInt32(alarm.EvaluationPeriods))
+		}
+
+		if a.thresholdKey != "" {
+			m[a.thresholdKey] = aws.ToFloat64(alarm.Threshold) / a.scale
+		}
+
+		alarmNames = append(alarmNames, name)
+	}
+
+	m["alarm_names"] = alarmNames
+
+	return []interface{}{m}, nil
+}
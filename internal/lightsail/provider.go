@@ -66,21 +66,36 @@ func Provider() *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"awslightsail_availability_zones": DataSourceAvailabilityZones(),
+			"awslightsail_availability_zones":            DataSourceAvailabilityZones(),
+			"awslightsail_container_service_metric_data": DataSourceContainerServiceMetricData(),
+			"awslightsail_database_log_events":           DataSourceDatabaseLogEvents(),
+			"awslightsail_database_log_streams":          DataSourceDatabaseLogStreams(),
+			"awslightsail_database_metric_data":          DataSourceDatabaseMetricData(),
+			"awslightsail_distribution_bundles":          DataSourceDistributionBundles(),
+			"awslightsail_instance_metric_data":          DataSourceInstanceMetricData(),
+			"awslightsail_lb_metric_data":                DataSourceLoadBalancerMetricData(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"awslightsail_alarm":                         ResourceAlarm(),
 			"awslightsail_bucket":                        ResourceBucket(),
+			"awslightsail_bucket_access_key":             ResourceBucketAccessKey(),
+			"awslightsail_bucket_resource_access":        ResourceBucketResourceAccess(),
 			"awslightsail_certificate":                   ResourceCertificate(),
 			"awslightsail_contact_method":                ResourceContactMethod(),
 			"awslightsail_container_deployment":          ResourceContainerDeployment(),
 			"awslightsail_container_public_domain_names": ResourceContainerPublicDomainNames(),
 			"awslightsail_container_service":             ResourceContainerService(),
 			"awslightsail_database":                      ResourceDatabase(),
+			"awslightsail_database_parameters":           ResourceDatabaseParameters(),
+			"awslightsail_database_snapshot":             ResourceDatabaseSnapshot(),
 			"awslightsail_disk":                          ResourceDisk(),
 			"awslightsail_disk_attachment":               ResourceDiskAttachment(),
+			"awslightsail_distribution":                  ResourceDistribution(),
+			"awslightsail_distribution_cache_reset":      ResourceDistributionCacheReset(),
 			"awslightsail_domain":                        ResourceDomain(),
 			"awslightsail_domain_entry":                  ResourceDomainEntry(),
+			"awslightsail_domain_records":                ResourceDomainRecords(),
 			"awslightsail_instance":                      ResourceInstance(),
 			"awslightsail_key_pair":                      ResourceKeyPair(),
 			"awslightsail_lb":                            ResourceLoadBalancer(),
@@ -2,14 +2,13 @@ package lightsail
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
-	"github.com/aws/smithy-go"
 	"github.com/deyoungtech/terraform-provider-awslightsail/internal/conns"
+	"github.com/deyoungtech/terraform-provider-awslightsail/internal/errs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -75,15 +74,16 @@ func resourceStaticIPAttachmentRead(d *schema.ResourceData, meta interface{}) er
 		StaticIpName: aws.String(d.Id()),
 	})
 
-	if err != nil {
-		var oe *smithy.OperationError
-		if errors.As(err, &oe) {
-			log.Printf("failed to call service: %s, operation: %s, error: %v", oe.Service(), oe.Operation(), oe.Unwrap())
-		}
+	if errs.IsNotFound(err) {
+		log.Printf("[WARN] Lightsail Static IP Attachment (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Static IP Attachment (%s): %w", d.Id(), err)
+	}
+
 	if !*resp.StaticIp.IsAttached {
 		log.Printf("[WARN] Lightsail Static IP (%s) is not attached, removing from state", d.Id())
 		d.SetId("")
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -86,6 +87,30 @@ func statusLightsailDatabaseBackupRetention(conn *lightsail.Client, db *string)
 	}
 }
 
+// statusLightsailContactMethod is a method to check the verification status of a Lightsail Contact Method
+func statusLightsailContactMethod(conn *lightsail.Client, protocol string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Checking if Lightsail Contact Method (%s) is verified", protocol)
+
+		output, err := conn.GetContactMethods(context.TODO(), &lightsail.GetContactMethodsInput{
+			Protocols: []types.ContactProtocol{types.ContactProtocol(protocol)},
+		})
+
+		if err != nil {
+			return output, "FAILED", err
+		}
+
+		for _, c := range output.ContactMethods {
+			if string(c.Protocol) == protocol {
+				log.Printf("[DEBUG] Lightsail Contact Method (%s) is currently %q", protocol, c.Status)
+				return c, string(c.Status), nil
+			}
+		}
+
+		return nil, "Failed", fmt.Errorf("Error retrieving Contact Method info for (%s)", protocol)
+	}
+}
+
 // call GetContainerServices to check the current state of the container service
 func statusLightsailContainerService(conn *lightsail.Client, cs *string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
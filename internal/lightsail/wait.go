@@ -32,6 +32,16 @@ const (
 	DatabaseStateModifying = "modifying"
 	// DatabaseStateAvailable is a state value for a Relational Database available for modification
 	DatabaseStateAvailable = "available"
+	// DatabaseStateBackingUp is a state value for a Relational Database creating a backup
+	DatabaseStateBackingUp = "backing-up"
+	// DatabaseStateRebooting is a state value for a Relational Database that is rebooting
+	DatabaseStateRebooting = "rebooting"
+	// DatabaseStateStarting is a state value for a Relational Database that is starting
+	DatabaseStateStarting = "starting"
+	// DatabaseStateStopping is a state value for a Relational Database that is stopping
+	DatabaseStateStopping = "stopping"
+	// DatabaseStateStopped is a state value for a stopped Relational Database
+	DatabaseStateStopped = "stopped"
 
 	// DatabaseTimeout is the Timout Value for Relational Database Modifications
 	DatabaseTimeout = 20 * time.Minute
@@ -40,6 +50,16 @@ const (
 	// DatabaseMinTimeout is the MinTimout Value for Relational Database Modifications
 	DatabaseMinTimeout = 3 * time.Second
 
+	// ContactMethodStatusPendingVerification is a status value for a Contact Method that has not been verified yet
+	ContactMethodStatusPendingVerification = "PendingVerification"
+	// ContactMethodStatusValid is a status value for a verified Contact Method
+	ContactMethodStatusValid = "Valid"
+
+	// ContactMethodVerificationDelay is the Delay Value for Contact Method Verification
+	ContactMethodVerificationDelay = 10 * time.Second
+	// ContactMethodVerificationMinTimeout is the MinTimout Value for Contact Method Verification
+	ContactMethodVerificationMinTimeout = 10 * time.Second
+
 	// The current state of the container service. The following container service
 	// * PENDING - The container service is being created.
 	ContainerServiceStatePending = "PENDING"
@@ -80,10 +100,50 @@ func waitLightsailOperation(conn *lightsail.Client, oid *string) error {
 	return err
 }
 
-// waitDatabaseModified waits for a Modified Database return available
+// waitDatabaseModified waits for a Modified Database return available, or stopped for a stopped Database
 func waitDatabaseModified(conn *lightsail.Client, db *string) error {
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{DatabaseStateModifying},
+		Pending:    []string{DatabaseStateModifying, DatabaseStateBackingUp, DatabaseStateRebooting, DatabaseStateStarting, DatabaseStateStopping},
+		Target:     []string{DatabaseStateAvailable, DatabaseStateStopped},
+		Refresh:    statusLightsailDatabase(conn, db),
+		Timeout:    DatabaseTimeout,
+		Delay:      DatabaseDelay,
+		MinTimeout: DatabaseMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if _, ok := outputRaw.(*lightsail.GetRelationalDatabaseOutput); ok {
+		return err
+	}
+
+	return err
+}
+
+// waitDatabaseRebooted waits for a Rebooting Database return available
+func waitDatabaseRebooted(conn *lightsail.Client, db *string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{DatabaseStateRebooting, DatabaseStateModifying},
+		Target:     []string{DatabaseStateAvailable},
+		Refresh:    statusLightsailDatabase(conn, db),
+		Timeout:    DatabaseTimeout,
+		Delay:      DatabaseDelay,
+		MinTimeout: DatabaseMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if _, ok := outputRaw.(*lightsail.GetRelationalDatabaseOutput); ok {
+		return err
+	}
+
+	return err
+}
+
+// waitDatabaseStarted waits for a Starting Database return available
+func waitDatabaseStarted(conn *lightsail.Client, db *string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{DatabaseStateStarting, DatabaseStateStopped},
 		Target:     []string{DatabaseStateAvailable},
 		Refresh:    statusLightsailDatabase(conn, db),
 		Timeout:    DatabaseTimeout,
@@ -100,6 +160,26 @@ func waitDatabaseModified(conn *lightsail.Client, db *string) error {
 	return err
 }
 
+// waitDatabaseStopped waits for a Stopping Database return stopped
+func waitDatabaseStopped(conn *lightsail.Client, db *string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{DatabaseStateStopping, DatabaseStateAvailable},
+		Target:     []string{DatabaseStateStopped},
+		Refresh:    statusLightsailDatabase(conn, db),
+		Timeout:    DatabaseTimeout,
+		Delay:      DatabaseDelay,
+		MinTimeout: DatabaseMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if _, ok := outputRaw.(*lightsail.GetRelationalDatabaseOutput); ok {
+		return err
+	}
+
+	return err
+}
+
 // waitDatabaseBackupRetentionModified waits for a Modified  BackupRetention on Database return available
 
 func waitDatabaseBackupRetentionModified(conn *lightsail.Client, db *string, status *bool) error {
@@ -140,3 +220,19 @@ func waitContainerService(conn *lightsail.Client, cs *string) error {
 
 	return err
 }
+
+// waitContactMethodVerified waits for a Contact Method to return Valid
+func waitContactMethodVerified(conn *lightsail.Client, protocol string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ContactMethodStatusPendingVerification},
+		Target:     []string{ContactMethodStatusValid},
+		Refresh:    statusLightsailContactMethod(conn, protocol),
+		Timeout:    timeout,
+		Delay:      ContactMethodVerificationDelay,
+		MinTimeout: ContactMethodVerificationMinTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}